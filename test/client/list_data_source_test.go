@@ -0,0 +1,36 @@
+package client
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+const clientsDataResource = `
+data uaa_clients "admins" {
+	filter = "authorized_grant_types co \"client_credentials\" and authorities co \"uaa.admin\""
+	sort_by = "client_id"
+}
+`
+
+func TestClientsDataSource_normal(t *testing.T) {
+	ref := "data.uaa_clients.admins"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: clientsDataResource,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet(ref, "id"),
+						resource.TestCheckResourceAttr(ref, "zone_id", "uaa"),
+						resource.TestCheckTypeSetElemNestedAttrs(ref, "clients.*", map[string]string{
+							"client_id": "admin",
+						}),
+					),
+				},
+			},
+		})
+}
@@ -47,6 +47,73 @@ resource "uaa_client" "client2" {
 }
 `
 
+const clientResourceRotate = `
+resource "uaa_client" "client4" {
+    client_id = "my-name-rotate"
+    authorized_grant_types = [ "client_credentials" ]
+    redirect_uri = [ "https://uaa.local.pcfdev.io/login" ]
+    client_secret = "%s"
+    secondary_client_secret = "%s"
+}
+`
+
+const clientResourceJwksUri = `
+resource "uaa_client" "client5" {
+    client_id = "my-name-jwt"
+    authorized_grant_types = [ "client_credentials" ]
+    redirect_uri = [ "https://uaa.local.pcfdev.io/login" ]
+    jwks_uri = "https://my-service.example.com/.well-known/jwks.json"
+}
+`
+
+const clientResourceJwtCreds = `
+resource "uaa_client" "client5" {
+    client_id = "my-name-jwt"
+    authorized_grant_types = [ "client_credentials" ]
+    redirect_uri = [ "https://uaa.local.pcfdev.io/login" ]
+    jwks_uri = "https://my-service.example.com/.well-known/other-jwks.json"
+    jwt_creds {
+        issuer = "https://issuer.example.com"
+        subject = "my-workload"
+        audience = "my-name-jwt"
+    }
+}
+`
+
+const clientResourceJwtAndSecret = `
+resource "uaa_client" "client5" {
+    client_id = "my-name-jwt"
+    authorized_grant_types = [ "client_credentials" ]
+    redirect_uri = [ "https://uaa.local.pcfdev.io/login" ]
+    client_secret = "mysecret"
+    jwks_uri = "https://my-service.example.com/.well-known/jwks.json"
+}
+`
+
+const clientResourceMeta = `
+resource "uaa_client" "client6" {
+    client_id = "my-name-meta"
+    authorized_grant_types = [ "client_credentials" ]
+    redirect_uri = [ "https://uaa.local.pcfdev.io/login" ]
+    client_secret = "mysecret"
+    meta {
+        app_launch_url = "https://my-app.example.com"
+        show_on_home_page = true
+        app_icon = "aWNvbg=="
+        client_name = "My App"
+    }
+}
+`
+
+const clientResourceMetaRemoved = `
+resource "uaa_client" "client6" {
+    client_id = "my-name-meta"
+    authorized_grant_types = [ "client_credentials" ]
+    redirect_uri = [ "https://uaa.local.pcfdev.io/login" ]
+    client_secret = "mysecret"
+}
+`
+
 const clientResourceWithScope = `
 resource "uaa_client" "client3" {
     client_id = "my-name-scope"
@@ -100,6 +167,124 @@ func TestAccClient_normal(t *testing.T) {
 						util.TestCheckResourceSet(ref, "redirect_uri", []string{"https://uaa.local.pcfdev.io/login"}),
 					),
 				},
+				{
+					ResourceName:            ref,
+					ImportState:             true,
+					ImportStateIdFunc:       util.ImportStateIdFunc(ref, "client_id"),
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"client_secret", "secondary_client_secret"},
+				},
+			},
+		})
+}
+
+func TestAccClient_secretRotation(t *testing.T) {
+	ref := "uaa_client.client4"
+	clientid := "my-name-rotate"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testClientDestroyed(clientid),
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(clientResourceRotate, "oldsecret", ""),
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckClientExists(ref, test.DefaultZoneId),
+						testAccCheckValidSecret(ref, "oldsecret", test.DefaultZoneId),
+						testAccCheckInvalidSecret(ref, "newsecret", test.DefaultZoneId),
+					),
+				},
+				{
+					Config: fmt.Sprintf(clientResourceRotate, "oldsecret", "newsecret"),
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckValidSecret(ref, "oldsecret", test.DefaultZoneId),
+						testAccCheckValidSecret(ref, "newsecret", test.DefaultZoneId),
+					),
+				},
+				{
+					Config: fmt.Sprintf(clientResourceRotate, "newsecret", ""),
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckInvalidSecret(ref, "oldsecret", test.DefaultZoneId),
+						testAccCheckValidSecret(ref, "newsecret", test.DefaultZoneId),
+					),
+				},
+			},
+		})
+}
+
+func TestAccClient_jwt(t *testing.T) {
+	ref := "uaa_client.client5"
+	clientid := "my-name-jwt"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testClientDestroyed(clientid),
+			Steps: []resource.TestStep{
+				{
+					Config:      clientResourceJwtAndSecret,
+					ExpectError: regexp.MustCompile(".*conflicts with.*"),
+				},
+				{
+					Config: clientResourceJwksUri,
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckClientExists(ref, test.DefaultZoneId),
+						resource.TestCheckResourceAttr(ref, "jwks_uri", "https://my-service.example.com/.well-known/jwks.json"),
+						resource.TestCheckResourceAttr(ref, "jwt_creds.#", "0"),
+					),
+				},
+				{
+					Config: clientResourceJwtCreds,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "jwks_uri", "https://my-service.example.com/.well-known/other-jwks.json"),
+						resource.TestCheckResourceAttr(ref, "jwt_creds.#", "1"),
+						resource.TestCheckTypeSetElemNestedAttrs(ref, "jwt_creds.*", map[string]string{
+							"issuer":   "https://issuer.example.com",
+							"subject":  "my-workload",
+							"audience": "my-name-jwt",
+						}),
+					),
+				},
+				{
+					ResourceName:      ref,
+					ImportState:       true,
+					ImportStateIdFunc: util.ImportStateIdFunc(ref, "client_id"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+}
+
+func TestAccClient_meta(t *testing.T) {
+	ref := "uaa_client.client6"
+	clientid := "my-name-meta"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testClientDestroyed(clientid),
+			Steps: []resource.TestStep{
+				{
+					Config: clientResourceMeta,
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckClientExists(ref, test.DefaultZoneId),
+						resource.TestCheckResourceAttr(ref, "meta.#", "1"),
+						resource.TestCheckResourceAttr(ref, "meta.0.app_launch_url", "https://my-app.example.com"),
+						resource.TestCheckResourceAttr(ref, "meta.0.show_on_home_page", "true"),
+						resource.TestCheckResourceAttr(ref, "meta.0.app_icon", "aWNvbg=="),
+						resource.TestCheckResourceAttr(ref, "meta.0.client_name", "My App"),
+					),
+				},
+				{
+					Config: clientResourceMetaRemoved,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "meta.#", "0"),
+					),
+				},
 			},
 		})
 }
@@ -168,6 +353,15 @@ func testAccCheckValidSecret(resource, secret, zoneId string) resource.TestCheck
 	}
 }
 
+func testAccCheckInvalidSecret(resource, secret, zoneId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if err := testAccCheckValidSecret(resource, secret, zoneId)(s); err == nil {
+			return fmt.Errorf("secret '%s' of client '%s' is still valid", secret, resource)
+		}
+		return nil
+	}
+}
+
 func testAccCheckClientExists(resource, zoneId string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resource]
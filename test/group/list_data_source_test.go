@@ -0,0 +1,39 @@
+package group
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+const groupsDataResource = `
+data uaa_groups "uaa" {
+	filter = "displayName sw \"uaa.\""
+	sort_by = "displayName"
+	sort_order = "descending"
+}
+`
+
+func TestGroupsDataSource_normal(t *testing.T) {
+	ref := "data.uaa_groups.uaa"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: groupsDataResource,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet(ref, "id"),
+						resource.TestCheckResourceAttr(ref, "zone_id", "uaa"),
+						resource.TestCheckTypeSetElemNestedAttrs(ref, "groups.*", map[string]string{
+							"display_name": "uaa.admin",
+							"description":  "Act as an administrator throughout the UAA",
+						}),
+						resource.TestCheckResourceAttr(ref, "groups.0.display_name", "uaa.user"),
+					),
+				},
+			},
+		})
+}
@@ -46,6 +46,7 @@ func TestGroupResource_normal(t *testing.T) {
 						resource.TestCheckResourceAttrSet(ref, "id"),
 						resource.TestCheckResourceAttr(ref, "display_name", originalDisplayName),
 						resource.TestCheckResourceAttr(ref, "description", originalDescription),
+						resource.TestCheckResourceAttr(ref, "version", "0"),
 						resource.TestCheckResourceAttr(ref, "zone_id", test.DefaultZoneId),
 					),
 				},
@@ -56,6 +57,7 @@ func TestGroupResource_normal(t *testing.T) {
 						resource.TestCheckResourceAttrSet(ref, "id"),
 						resource.TestCheckResourceAttr(ref, "display_name", originalDisplayName),
 						resource.TestCheckResourceAttr(ref, "description", updatedDescription),
+						resource.TestCheckResourceAttr(ref, "version", "1"),
 						resource.TestCheckResourceAttr(ref, "zone_id", test.DefaultZoneId),
 					),
 				},
@@ -79,6 +81,12 @@ func TestGroupResource_normal(t *testing.T) {
 						resource.TestCheckResourceAttr(ref, "zone_id", test.UpdatedZoneId),
 					),
 				},
+				{
+					ResourceName:      ref,
+					ImportState:       true,
+					ImportStateIdFunc: util.ImportStateIdFunc(ref, "display_name"),
+					ImportStateVerify: true,
+				},
 			},
 		})
 }
@@ -0,0 +1,119 @@
+package groupmapping
+
+import (
+	"code.cloudfoundry.org/cli/cf/errors"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/test"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+const ref = "uaa_group_mapping.mapping"
+const groupName = "mapping.test.group"
+const externalGroup = "cn=test-admins,ou=groups,dc=example,dc=com"
+
+func createTestResource(groupReference string) string {
+	return `
+resource uaa_group "group" {
+	display_name = "` + groupName + `"
+}
+
+resource uaa_group_mapping "mapping" {
+	` + groupReference + `
+	external_group = "` + externalGroup + `"
+	origin = "ldap"
+}`
+}
+
+func TestResource_normal(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testCheckDestroyed,
+			Steps: []resource.TestStep{
+				{
+					Config: createTestResource(`group_id = uaa_group.group.id`),
+					Check: resource.ComposeTestCheckFunc(
+						checkGroupMappingExists(ref),
+						resource.TestCheckResourceAttrPair(ref, "group_id", "uaa_group.group", "id"),
+						resource.TestCheckResourceAttr(ref, "group_name", groupName),
+						resource.TestCheckResourceAttr(ref, "external_group", externalGroup),
+						resource.TestCheckResourceAttr(ref, "origin", "ldap"),
+						resource.TestCheckResourceAttr(ref, "zone_id", test.DefaultZoneId),
+					),
+				},
+				{
+					Config: createTestResource(`group_name = uaa_group.group.display_name`),
+					Check: resource.ComposeTestCheckFunc(
+						checkGroupMappingExists(ref),
+						resource.TestCheckResourceAttrPair(ref, "group_id", "uaa_group.group", "id"),
+						resource.TestCheckResourceAttr(ref, "group_name", groupName),
+					),
+				},
+				{
+					ResourceName:      ref,
+					ImportState:       true,
+					ImportStateId:     fmt.Sprintf("%s/ldap/%s/%s", test.DefaultZoneId, groupName, externalGroup),
+					ImportStateVerify: true,
+				},
+			},
+		})
+}
+
+func TestResource_createError(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      createTestResource(``),
+					ExpectError: regexp.MustCompile("one of `group_id,group_name` must be specified"),
+				},
+			},
+		})
+}
+
+func checkGroupMappingExists(ref string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[ref]
+		if !ok {
+			return fmt.Errorf("group mapping '%s' not found in terraform state", ref)
+		}
+
+		_, err := util.UaaSession().GroupManager().FindExternalGroupMapping(
+			rs.Primary.Attributes["group_id"],
+			rs.Primary.Attributes["external_group"],
+			rs.Primary.Attributes["origin"],
+			rs.Primary.Attributes["zone_id"],
+		)
+		return err
+	}
+}
+
+func testCheckDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "uaa_group_mapping" {
+			continue
+		}
+		_, err := util.UaaSession().GroupManager().FindExternalGroupMapping(
+			rs.Primary.Attributes["group_id"],
+			rs.Primary.Attributes["external_group"],
+			rs.Primary.Attributes["origin"],
+			rs.Primary.Attributes["zone_id"],
+		)
+		switch err.(type) {
+		case *errors.ModelNotFoundError:
+			continue
+		case nil:
+			return fmt.Errorf("group mapping '%s' still exists in UAA", rs.Primary.ID)
+		default:
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package groupmembership
+
+import (
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/test"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+const ref = "uaa_group_membership.members"
+const parentGroupRef = "uaa_group.parent"
+
+func createTestResource(authoritative bool, members ...string) string {
+	memberBlocks := ""
+	for _, m := range members {
+		memberBlocks += m
+	}
+	return `
+resource uaa_group "parent" {
+	display_name = "membership.parent"
+}
+
+resource uaa_group "child" {
+	display_name = "membership.child"
+}
+
+resource uaa_user "member" {
+	name = "membership-user"
+	password = "qwerty"
+
+	lifecycle {
+		ignore_changes = [groups]
+	}
+}
+
+resource uaa_group_membership "members" {
+	group_id = uaa_group.parent.id
+	authoritative = ` + fmt.Sprintf("%t", authoritative) + `
+	` + memberBlocks + `
+}`
+}
+
+const userMember = `
+	member {
+		value = uaa_user.member.id
+	}
+`
+
+const groupMember = `
+	member {
+		type = "GROUP"
+		value = uaa_group.child.id
+	}
+`
+
+func TestResource_normal(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: createTestResource(false, userMember),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair(ref, "group_id", parentGroupRef, "id"),
+						resource.TestMatchResourceAttr(ref, "id", regexp.MustCompile(`^[^/]+/additive$`)),
+						resource.TestCheckResourceAttr(ref, "zone_id", test.DefaultZoneId),
+						resource.TestCheckResourceAttr(ref, "member.#", "1"),
+						checkMemberCount(parentGroupRef, 1),
+					),
+				},
+				{
+					Config: createTestResource(false, userMember, groupMember),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "member.#", "2"),
+						checkMemberCount(parentGroupRef, 2),
+					),
+				},
+				{
+					Config: createTestResource(true, groupMember),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "authoritative", "true"),
+						resource.TestCheckResourceAttrPair(ref, "id", parentGroupRef, "id"),
+						resource.TestCheckResourceAttr(ref, "member.#", "1"),
+						checkMemberCount(parentGroupRef, 1),
+					),
+				},
+				{
+					ResourceName:      ref,
+					ImportState:       true,
+					ImportStateIdFunc: util.ImportStateIdFunc(ref, "group_id"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+}
+
+func checkMemberCount(groupRef string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[groupRef]
+		if !ok {
+			return fmt.Errorf("group '%s' not found in terraform state", groupRef)
+		}
+
+		members, err := util.UaaSession().GroupManager().GetMembers(rs.Primary.ID, test.DefaultZoneId)
+		if err != nil {
+			return err
+		}
+		if len(members) != count {
+			return fmt.Errorf("expected group '%s' to have %d members but found %d", groupRef, count, len(members))
+		}
+		return nil
+	}
+}
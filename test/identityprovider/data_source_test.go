@@ -0,0 +1,89 @@
+package identityprovider
+
+import (
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+const dataSource = `
+data uaa_identity_provider "uaa" {
+	origin_key = "uaa"
+}
+`
+
+const dataSourceNotFound = `
+data uaa_identity_provider "not-found" {
+	origin_key = "not-found"
+}
+`
+
+func TestDataSource_normal(t *testing.T) {
+	ref := "data.uaa_identity_provider.uaa"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: dataSource,
+					Check: resource.ComposeTestCheckFunc(
+						checkIdentityProviderExists(ref),
+						resource.TestCheckResourceAttrSet(ref, "id"),
+						resource.TestCheckResourceAttr(ref, "origin_key", "uaa"),
+						resource.TestCheckResourceAttr(ref, "type", "uaa"),
+						resource.TestCheckResourceAttr(ref, "is_active", "true"),
+					),
+				},
+			},
+		})
+}
+
+func TestDataSource_notFound(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      dataSourceNotFound,
+					ExpectError: regexp.MustCompile(".*Identity Provider not-found not found.*"),
+				},
+			},
+		})
+}
+
+func checkIdentityProviderExists(resource string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("identity provider '%s' not found in terraform state", resource)
+		}
+
+		util.UaaSession().Log.DebugMessage(
+			"terraform state for resource '%s': %# v",
+			resource, rs)
+
+		id := rs.Primary.ID
+		zoneId := rs.Primary.Attributes["zone_id"]
+
+		identityProvider, err := util.UaaSession().IdentityProviderManager().FindById(id, zoneId)
+		if err != nil {
+			return err
+		}
+		if err := util.AssertSame(identityProvider.Id, id); err != nil {
+			return err
+		}
+		if err := util.AssertEquals(rs.Primary.Attributes, "origin_key", identityProvider.OriginKey); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,131 @@
+package identityprovider
+
+import (
+	"code.cloudfoundry.org/cli/cf/errors"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/test"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+const ref = "uaa_identity_provider.oidc"
+const originKey = "test-oidc"
+const originalName = "Test OIDC Provider"
+const updatedName = "Updated OIDC Provider"
+
+func createTestResource(name, zoneId string) string {
+	zone := ""
+	if zoneId != "" {
+		zone = `zone_id = "` + zoneId + `"`
+	}
+	return `resource uaa_identity_provider "oidc" {
+		name = "` + name + `"
+		origin_key = "` + originKey + `"
+		type = "oidc1.0"
+		` + zone + `
+		email_domain = ["example.com"]
+		attribute_mappings = {
+			given_name = "given_name"
+		}
+		oauth_config {
+			auth_url = "https://oidc.example.com/oauth/authorize"
+			token_url = "https://oidc.example.com/oauth/token"
+			token_key_url = "https://oidc.example.com/token_keys"
+			issuer = "https://oidc.example.com"
+			relying_party_id = "uaa"
+			relying_party_secret = "secret"
+			scopes = ["openid", "email"]
+		}
+	}`
+}
+
+const resourceWithoutConfig = `
+resource uaa_identity_provider "oidc" {
+	name = "no-config"
+	origin_key = "no-config"
+	type = "oidc1.0"
+}
+`
+
+func TestResource_normal(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testCheckDestroyed(originKey),
+			Steps: []resource.TestStep{
+				{
+					Config: createTestResource(originalName, ""),
+					Check: resource.ComposeTestCheckFunc(
+						checkIdentityProviderExists(ref),
+						resource.TestCheckResourceAttrSet(ref, "id"),
+						resource.TestCheckResourceAttr(ref, "name", originalName),
+						resource.TestCheckResourceAttr(ref, "origin_key", originKey),
+						resource.TestCheckResourceAttr(ref, "type", "oidc1.0"),
+						resource.TestCheckResourceAttr(ref, "zone_id", test.DefaultZoneId),
+						resource.TestCheckResourceAttr(ref, "attribute_mappings.given_name", "given_name"),
+						resource.TestCheckResourceAttr(ref, "oauth_config.0.relying_party_id", "uaa"),
+						resource.TestCheckResourceAttr(ref, "oauth_config.0.relying_party_secret", "secret"),
+					),
+				},
+				{
+					Config: createTestResource(updatedName, ""),
+					Check: resource.ComposeTestCheckFunc(
+						checkIdentityProviderExists(ref),
+						resource.TestCheckResourceAttr(ref, "name", updatedName),
+						resource.TestCheckResourceAttr(ref, "zone_id", test.DefaultZoneId),
+					),
+				},
+				{
+					Config: createTestResource(updatedName, test.UpdatedZoneId),
+					Check: resource.ComposeTestCheckFunc(
+						checkIdentityProviderExists(ref),
+						resource.TestCheckResourceAttr(ref, "name", updatedName),
+						resource.TestCheckResourceAttr(ref, "zone_id", test.UpdatedZoneId),
+					),
+				},
+				{
+					ResourceName:            ref,
+					ImportState:             true,
+					ImportStateIdFunc:       util.ImportStateIdFunc(ref, "origin_key"),
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"oauth_config.0.relying_party_secret"},
+				},
+			},
+		})
+}
+
+func TestResource_createError(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      resourceWithoutConfig,
+					ExpectError: regexp.MustCompile(".*one of `ldap_config,oauth_config,saml_config` must be specified.*"),
+				},
+			},
+		})
+}
+
+func testCheckDestroyed(originKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ipm := util.UaaSession().IdentityProviderManager()
+		for _, zoneId := range []string{test.DefaultZoneId, test.UpdatedZoneId} {
+			if _, err := ipm.FindByOriginKey(originKey, zoneId); err != nil {
+				switch err.(type) {
+				case *errors.ModelNotFoundError:
+					continue
+				default:
+					return err
+				}
+			}
+			return fmt.Errorf("identity provider with origin key '%s' still exists in zone '%s'", originKey, zoneId)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,42 @@
+package identityzone
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/test"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+const identityZonesDataResource = `
+data uaa_identity_zones "zones" {
+	name_prefix = "uaa"
+}
+
+data uaa_identity_zones "test-zone" {
+	sub_domain = "` + test.UpdatedZoneId + `"
+}
+`
+
+func TestIdentityZonesDataSource_normal(t *testing.T) {
+	ref := "data.uaa_identity_zones.zones"
+	testZoneRef := "data.uaa_identity_zones.test-zone"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: identityZonesDataResource,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet(ref, "id"),
+						resource.TestCheckResourceAttr(ref, "identity_zones.#", "1"),
+						resource.TestCheckResourceAttr(ref, "identity_zones.0.id", "uaa"),
+						resource.TestCheckResourceAttr(ref, "identity_zones.0.is_active", "true"),
+						resource.TestCheckResourceAttr(testZoneRef, "identity_zones.#", "1"),
+						resource.TestCheckResourceAttr(testZoneRef, "identity_zones.0.id", test.UpdatedZoneId),
+					),
+				},
+			},
+		})
+}
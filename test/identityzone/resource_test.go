@@ -2,11 +2,16 @@ package identityzone
 
 import (
 	"code.cloudfoundry.org/cli/cf/errors"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"github.com/foundcloudry/terraform-provider-uaa/test/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -65,10 +70,174 @@ func TestResource_normal(t *testing.T) {
 						resource.TestCheckResourceAttr(ref, "sub_domain", updatedSubdomain),
 					),
 				},
+				{
+					ResourceName:      ref,
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+}
+
+func createTestResourceWithSigningKeys(activeKeyId string, keys map[string]string) string {
+	signingKeys := ""
+	for keyId, key := range keys {
+		signingKeys += `
+			signing_keys {
+				key_id = "` + keyId + `"
+				signing_key = <<-EOT
+` + key + `
+				EOT
+			}`
+	}
+	return `resource uaa_identity_zone "new-test-zone" {
+		name = "` + originalName + `"
+		sub_domain = "` + originalSubDomain + `"
+		token_policy {
+			active_key_id = "` + activeKeyId + `"
+			` + signingKeys + `
+		}
+	}`
+}
+
+func TestResource_signingKeys(t *testing.T) {
+	originalKey := generateSigningKey(t)
+	rotatedKey := generateSigningKey(t)
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testCheckDestroyed(),
+			Steps: []resource.TestStep{
+				{
+					Config: createTestResourceWithSigningKeys("original", map[string]string{"original": originalKey}),
+					Check: resource.ComposeTestCheckFunc(
+						checkIdentityZoneExists(ref),
+						resource.TestCheckResourceAttr(ref, "token_policy.0.active_key_id", "original"),
+						resource.TestCheckResourceAttr(ref, "token_policy.0.signing_keys.#", "1"),
+					),
+				},
+				{
+					Config: createTestResourceWithSigningKeys("original", map[string]string{"original": originalKey, "rotated": rotatedKey}),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "token_policy.0.active_key_id", "original"),
+						resource.TestCheckResourceAttr(ref, "token_policy.0.signing_keys.#", "2"),
+					),
+				},
+				{
+					Config: createTestResourceWithSigningKeys("rotated", map[string]string{"original": originalKey, "rotated": rotatedKey}),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "token_policy.0.active_key_id", "rotated"),
+						resource.TestCheckResourceAttr(ref, "token_policy.0.signing_keys.#", "2"),
+					),
+				},
+				{
+					Config: createTestResourceWithSigningKeys("rotated", map[string]string{"rotated": rotatedKey}),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "token_policy.0.active_key_id", "rotated"),
+						resource.TestCheckResourceAttr(ref, "token_policy.0.signing_keys.#", "1"),
+					),
+				},
+				{
+					Config:      createTestResourceWithSigningKeys("unknown", map[string]string{"rotated": rotatedKey}),
+					PlanOnly:    true,
+					ExpectError: regexp.MustCompile("active_key_id 'unknown' must match the key_id of one of its signing_keys"),
+				},
+				{
+					Config: createTestResourceWithSigningKeys("", map[string]string{}),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "token_policy.0.active_key_id", ""),
+						resource.TestCheckResourceAttr(ref, "token_policy.0.signing_keys.#", "0"),
+					),
+				},
 			},
 		})
 }
 
+func createTestResourceWithUserConfig(maxUsers int, checkOrigin bool, consentText string) string {
+	return fmt.Sprintf(`resource uaa_identity_zone "new-test-zone" {
+		name = "`+originalName+`"
+		sub_domain = "`+originalSubDomain+`"
+		default_identity_provider = "uaa"
+		default_user_groups = ["openid"]
+		allowed_user_groups = ["openid", "uaa.user"]
+		max_users = %d
+		check_origin_enabled = %t
+		logout_disable_redirect_param = true
+		branding {
+			company_name = "Test Company"
+			consent_text = "%s"
+			consent_link = "https://example.com/terms"
+		}
+	}`, maxUsers, checkOrigin, consentText)
+}
+
+func TestResource_userConfig(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testCheckDestroyed(),
+			Steps: []resource.TestStep{
+				{
+					Config: createTestResourceWithUserConfig(10, false, "Terms of Use"),
+					Check: resource.ComposeTestCheckFunc(
+						checkIdentityZoneExists(ref),
+						resource.TestCheckResourceAttr(ref, "default_identity_provider", "uaa"),
+						resource.TestCheckResourceAttr(ref, "allowed_user_groups.#", "2"),
+						resource.TestCheckResourceAttr(ref, "max_users", "10"),
+						resource.TestCheckResourceAttr(ref, "check_origin_enabled", "false"),
+						resource.TestCheckResourceAttr(ref, "logout_disable_redirect_param", "true"),
+						resource.TestCheckResourceAttr(ref, "branding.0.consent_text", "Terms of Use"),
+						resource.TestCheckResourceAttr(ref, "branding.0.consent_link", "https://example.com/terms"),
+					),
+				},
+				{
+					Config: createTestResourceWithUserConfig(20, true, "Updated Terms of Use"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "max_users", "20"),
+						resource.TestCheckResourceAttr(ref, "check_origin_enabled", "true"),
+						resource.TestCheckResourceAttr(ref, "branding.0.consent_text", "Updated Terms of Use"),
+					),
+				},
+				{
+					Config: createTestResourceWithoutUserConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "default_identity_provider", ""),
+						resource.TestCheckResourceAttr(ref, "allowed_user_groups.#", "0"),
+						resource.TestCheckResourceAttr(ref, "max_users", "-1"),
+						resource.TestCheckResourceAttr(ref, "logout_disable_redirect_param", "false"),
+						resource.TestCheckResourceAttr(ref, "branding.0.consent_text", ""),
+						resource.TestCheckResourceAttr(ref, "branding.0.consent_link", ""),
+					),
+				},
+			},
+		})
+}
+
+func createTestResourceWithoutUserConfig() string {
+	return `resource uaa_identity_zone "new-test-zone" {
+		name = "` + originalName + `"
+		sub_domain = "` + originalSubDomain + `"
+		default_user_groups = ["openid"]
+		branding {
+			company_name = "Test Company"
+		}
+	}`
+}
+
+func generateSigningKey(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})))
+}
+
 func TestResource_createError(t *testing.T) {
 	resource.Test(t,
 		resource.TestCase{
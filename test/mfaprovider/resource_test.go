@@ -0,0 +1,171 @@
+package mfaprovider
+
+import (
+	"code.cloudfoundry.org/cli/cf/errors"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/test"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+const ref = "uaa_mfa_provider.authenticator"
+const zoneRef = "uaa_identity_zone.mfa-zone"
+const providerName = "test-authenticator"
+
+func createTestResource(issuer string) string {
+	return `resource uaa_mfa_provider "authenticator" {
+		name = "` + providerName + `"
+		zone_id = "` + test.UpdatedZoneId + `"
+		issuer = "` + issuer + `"
+	}`
+}
+
+// The zone has to exist before the MFA provider can be added to it, and only then can the zone enable MFA with it, so a
+// new zone is created without MFA.  UAA won't delete a provider that a zone uses, so MFA is disabled again before the
+// resources are destroyed.
+func createTestZoneWithMfa(mfaProviderName string) string {
+	mfa := "mfa_enabled = false"
+	if mfaProviderName != "" {
+		mfa = `mfa_enabled = true
+		mfa_provider_name = "` + mfaProviderName + `"`
+	}
+	return `resource uaa_identity_zone "mfa-zone" {
+		name = "MFA Test Zone"
+		sub_domain = "mfa-int-test-zone"
+		` + mfa + `
+	}
+
+	resource uaa_mfa_provider "authenticator" {
+		name = "` + providerName + `"
+		zone_id = uaa_identity_zone.mfa-zone.id
+	}`
+}
+
+func TestResource_normal(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testCheckDestroyed(test.UpdatedZoneId),
+			Steps: []resource.TestStep{
+				{
+					Config: createTestResource("Test Issuer"),
+					Check: resource.ComposeTestCheckFunc(
+						checkMfaProviderExists(ref),
+						resource.TestCheckResourceAttrSet(ref, "id"),
+						resource.TestCheckResourceAttr(ref, "name", providerName),
+						resource.TestCheckResourceAttr(ref, "type", "google-authenticator"),
+						resource.TestCheckResourceAttr(ref, "zone_id", test.UpdatedZoneId),
+						resource.TestCheckResourceAttr(ref, "issuer", "Test Issuer"),
+						resource.TestCheckResourceAttr(ref, "algorithm", "SHA256"),
+						resource.TestCheckResourceAttr(ref, "digits", "6"),
+						resource.TestCheckResourceAttr(ref, "duration", "30"),
+					),
+				},
+				{
+					Config: createTestResource("Updated Issuer"),
+					Check: resource.ComposeTestCheckFunc(
+						checkMfaProviderExists(ref),
+						resource.TestCheckResourceAttr(ref, "issuer", "Updated Issuer"),
+					),
+				},
+				{
+					ResourceName:      ref,
+					ImportState:       true,
+					ImportStateIdFunc: util.ImportStateIdFunc(ref, "name"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+}
+
+func TestResource_zoneMfa(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: createTestZoneWithMfa(""),
+					Check: resource.ComposeTestCheckFunc(
+						checkMfaProviderExists(ref),
+						resource.TestCheckResourceAttr(zoneRef, "mfa_enabled", "false"),
+					),
+				},
+				{
+					Config:      createTestZoneWithMfa("unknown-authenticator"),
+					ExpectError: regexp.MustCompile("the MFA provider 'unknown-authenticator' doesn't exist in identity zone"),
+				},
+				{
+					Config: createTestZoneWithMfa(providerName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(zoneRef, "mfa_enabled", "true"),
+						resource.TestCheckResourceAttr(zoneRef, "mfa_provider_name", providerName),
+					),
+				},
+				{
+					Config: createTestZoneWithMfa(""),
+					Check:  resource.TestCheckResourceAttr(zoneRef, "mfa_enabled", "false"),
+				},
+			},
+		})
+}
+
+func TestResource_newZoneMfa(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: createTestZoneWithMfa(providerName),
+					Check: resource.ComposeTestCheckFunc(
+						checkMfaProviderExists(ref),
+						resource.TestCheckResourceAttr(zoneRef, "mfa_enabled", "false"),
+						resource.TestCheckResourceAttr(zoneRef, "mfa_provider_name", ""),
+					),
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config: createTestZoneWithMfa(providerName),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(zoneRef, "mfa_enabled", "true"),
+						resource.TestCheckResourceAttr(zoneRef, "mfa_provider_name", providerName),
+					),
+				},
+				{
+					Config: createTestZoneWithMfa(""),
+					Check:  resource.TestCheckResourceAttr(zoneRef, "mfa_enabled", "false"),
+				},
+			},
+		})
+}
+
+func checkMfaProviderExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("MFA provider '%s' not found in terraform state", resourceName)
+		}
+
+		_, err := util.UaaSession().MfaProviderManager().FindById(rs.Primary.ID, rs.Primary.Attributes["zone_id"])
+		return err
+	}
+}
+
+func testCheckDestroyed(zoneId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, err := util.UaaSession().MfaProviderManager().FindByName(providerName, zoneId); err != nil {
+			switch err.(type) {
+			case *errors.ModelNotFoundError:
+				return nil
+			default:
+				return err
+			}
+		}
+		return fmt.Errorf("MFA provider '%s' still exists in zone '%s'", providerName, zoneId)
+	}
+}
@@ -0,0 +1,111 @@
+package passwordpolicy
+
+import (
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/test"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+const ref = "uaa_password_policy.baseline"
+
+const passwordPolicyResource = `
+resource "uaa_password_policy" "baseline" {
+    zone_id = "` + test.UpdatedZoneId + `"
+    password_policy {
+        min_length = 12
+        require_upper_case_character = 1
+        require_special_character = 1
+        expire_password_in_months = 6
+    }
+}
+`
+
+const passwordAndLockoutPolicyResource = `
+resource "uaa_password_policy" "baseline" {
+    zone_id = "` + test.UpdatedZoneId + `"
+    password_policy {
+        min_length = 16
+        require_digit = 2
+    }
+    lockout_policy {
+        lockout_after_failures = 3
+        count_failures_within_seconds = 600
+        lockout_period_seconds = 900
+    }
+}
+`
+
+const invalidPasswordPolicyResource = `
+resource "uaa_password_policy" "baseline" {
+    zone_id = "` + test.UpdatedZoneId + `"
+    password_policy {
+        min_length = 32
+        max_length = 16
+    }
+}
+`
+
+func TestPasswordPolicyResource_normal(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testPasswordPolicyReset(test.UpdatedZoneId),
+			Steps: []resource.TestStep{
+				{
+					Config:      invalidPasswordPolicyResource,
+					ExpectError: regexp.MustCompile("min_length \\(32\\) must not be greater than max_length \\(16\\)"),
+				},
+				{
+					Config: passwordPolicyResource,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "id", test.UpdatedZoneId),
+						resource.TestCheckResourceAttr(ref, "password_policy.0.min_length", "12"),
+						resource.TestCheckResourceAttr(ref, "password_policy.0.max_length", "255"),
+						resource.TestCheckResourceAttr(ref, "password_policy.0.require_upper_case_character", "1"),
+						resource.TestCheckResourceAttr(ref, "password_policy.0.require_special_character", "1"),
+						resource.TestCheckResourceAttr(ref, "password_policy.0.expire_password_in_months", "6"),
+						resource.TestCheckResourceAttr(ref, "lockout_policy.#", "0"),
+					),
+				},
+				{
+					Config: passwordAndLockoutPolicyResource,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "password_policy.0.min_length", "16"),
+						resource.TestCheckResourceAttr(ref, "password_policy.0.require_digit", "2"),
+						resource.TestCheckResourceAttr(ref, "password_policy.0.require_upper_case_character", "0"),
+						resource.TestCheckResourceAttr(ref, "lockout_policy.0.lockout_after_failures", "3"),
+						resource.TestCheckResourceAttr(ref, "lockout_policy.0.count_failures_within_seconds", "600"),
+						resource.TestCheckResourceAttr(ref, "lockout_policy.0.lockout_period_seconds", "900"),
+					),
+				},
+				{
+					ResourceName:      ref,
+					ImportState:       true,
+					ImportStateId:     test.UpdatedZoneId,
+					ImportStateVerify: true,
+				},
+			},
+		})
+}
+
+func testPasswordPolicyReset(zoneId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		policies, err := util.UaaSession().IdentityProviderManager().GetUaaPolicies(zoneId)
+		if err != nil {
+			return err
+		}
+		if p := policies.PasswordPolicy; p != nil && p.MinLength == 16 {
+			return fmt.Errorf("password policy of zone '%s' was not reset", zoneId)
+		}
+		if p := policies.LockoutPolicy; p != nil && p.LockoutAfterFailures == 3 {
+			return fmt.Errorf("lockout policy of zone '%s' was not reset", zoneId)
+		}
+		return nil
+	}
+}
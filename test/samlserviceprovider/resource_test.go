@@ -0,0 +1,106 @@
+package samlserviceprovider
+
+import (
+	"code.cloudfoundry.org/cli/cf/errors"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/test"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+const ref = "uaa_saml_service_provider.legacy"
+const entityId = "https://legacy-app.example.com"
+const originalName = "Legacy App"
+const updatedName = "Updated Legacy App"
+
+const metadata = `<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="` + entityId + `">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol" AuthnRequestsSigned="false" WantAssertionsSigned="true">
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</md:NameIDFormat>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="` + entityId + `/saml/SSO" index="0" isDefault="true"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`
+
+func createTestResource(name string, enableIdpInitiatedSso bool) string {
+	return fmt.Sprintf(`resource uaa_saml_service_provider "legacy" {
+		name = "%s"
+		entity_id = "`+entityId+`"
+		zone_id = "`+test.UpdatedZoneId+`"
+		name_id = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+		enable_idp_initiated_sso = %t
+		attribute_mappings = {
+			given_name = "firstName"
+			family_name = "lastName"
+		}
+		metadata = <<-EOT
+%s
+		EOT
+	}`, name, enableIdpInitiatedSso, metadata)
+}
+
+func TestResource_normal(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testCheckDestroyed(),
+			Steps: []resource.TestStep{
+				{
+					Config: createTestResource(originalName, false),
+					Check: resource.ComposeTestCheckFunc(
+						checkSamlServiceProviderExists(ref),
+						resource.TestCheckResourceAttrSet(ref, "id"),
+						resource.TestCheckResourceAttr(ref, "name", originalName),
+						resource.TestCheckResourceAttr(ref, "entity_id", entityId),
+						resource.TestCheckResourceAttr(ref, "zone_id", test.UpdatedZoneId),
+						resource.TestCheckResourceAttr(ref, "is_active", "true"),
+						resource.TestCheckResourceAttr(ref, "enable_idp_initiated_sso", "false"),
+						resource.TestCheckResourceAttr(ref, "attribute_mappings.given_name", "firstName"),
+					),
+				},
+				{
+					Config: createTestResource(updatedName, true),
+					Check: resource.ComposeTestCheckFunc(
+						checkSamlServiceProviderExists(ref),
+						resource.TestCheckResourceAttr(ref, "name", updatedName),
+						resource.TestCheckResourceAttr(ref, "enable_idp_initiated_sso", "true"),
+					),
+				},
+				{
+					ResourceName:      ref,
+					ImportState:       true,
+					ImportStateIdFunc: util.ImportStateIdFunc(ref, "entity_id"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+}
+
+func checkSamlServiceProviderExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("SAML service provider '%s' not found in terraform state", resourceName)
+		}
+
+		id := rs.Primary.ID
+		_, err := util.UaaSession().SamlServiceProviderManager().FindById(id, rs.Primary.Attributes["zone_id"])
+		return err
+	}
+}
+
+func testCheckDestroyed() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, err := util.UaaSession().SamlServiceProviderManager().FindByEntityId(entityId, test.UpdatedZoneId); err != nil {
+			switch err.(type) {
+			case *errors.ModelNotFoundError:
+				return nil
+			default:
+				return err
+			}
+		}
+		return fmt.Errorf("SAML service provider with entity ID '%s' still exists", entityId)
+	}
+}
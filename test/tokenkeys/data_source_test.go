@@ -0,0 +1,39 @@
+package tokenkeys
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/test"
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+const ref = "data.uaa_token_keys.keys"
+
+const tokenKeysDataResource = `
+data uaa_token_keys "keys" {
+	zone_id = "` + test.DefaultZoneId + `"
+}
+`
+
+func TestTokenKeysDataSource_normal(t *testing.T) {
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tokenKeysDataResource,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(ref, "id", test.DefaultZoneId),
+						resource.TestCheckResourceAttrSet(ref, "active_key_id"),
+						resource.TestCheckResourceAttrSet(ref, "keys.0.kid"),
+						resource.TestCheckResourceAttrSet(ref, "keys.0.alg"),
+						resource.TestCheckResourceAttrSet(ref, "keys.0.kty"),
+						resource.TestCheckResourceAttrSet(ref, "keys.0.value"),
+						resource.TestMatchResourceAttr(ref, "jwks", regexp.MustCompile(`^\{"keys":\[`)),
+					),
+				},
+			},
+		})
+}
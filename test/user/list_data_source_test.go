@@ -0,0 +1,37 @@
+package user
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/test/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+const usersDataResource = `
+data uaa_users "admins" {
+	filter = "userName eq \"admin\" and origin eq \"uaa\""
+	attributes = [ "id", "userName", "origin", "emails", "groups" ]
+}
+`
+
+func TestUsersDataSource_normal(t *testing.T) {
+	ref := "data.uaa_users.admins"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: usersDataResource,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet(ref, "id"),
+						resource.TestCheckResourceAttr(ref, "users.#", "1"),
+						resource.TestCheckResourceAttrSet(ref, "users.0.id"),
+						resource.TestCheckResourceAttr(ref, "users.0.name", "admin"),
+						resource.TestCheckResourceAttr(ref, "users.0.origin", "uaa"),
+						resource.TestCheckTypeSetElemAttr(ref, "users.0.groups.*", "uaa.admin"),
+					),
+				},
+			},
+		})
+}
@@ -2,11 +2,14 @@ package user
 
 import (
 	"code.cloudfoundry.org/cli/cf/errors"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/foundcloudry/terraform-provider-uaa/test"
 	"github.com/foundcloudry/terraform-provider-uaa/test/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
 	"testing"
 )
 
@@ -41,6 +44,55 @@ resource "uaa_user" "admin-service-user" {
 }
 `
 
+const userResourceWithScimAttributes = `
+
+resource "uaa_user" "contractor" {
+	name = "contractor@acme.com"
+	password = "qwerty"
+	external_id = "c-1234"
+	given_name = "Jane"
+	family_name = "Contractor"
+	display_name = "Jane Contractor"
+	emails {
+		value = "contractor@acme.com"
+		primary = true
+	}
+	emails {
+		value = "jane@contracting.com"
+	}
+	phone_numbers = [ "+31 20 123 4567" ]
+	locale = "en-US"
+	timezone = "Europe/Amsterdam"
+}
+`
+
+const userResourceWithScimAttributesDeactivated = `
+
+resource "uaa_user" "contractor" {
+	name = "contractor@acme.com"
+	password = "qwerty"
+	external_id = "c-1234"
+	given_name = "Jane"
+	family_name = "Contractor"
+	display_name = "Jane Contractor"
+	emails {
+		value = "contractor@acme.com"
+		primary = true
+	}
+	emails {
+		value = "jane@contracting.com"
+	}
+	phone_numbers = [ "+31 20 123 4567" ]
+	locale = "en-US"
+	timezone = "Europe/Amsterdam"
+	active = false
+	verified = false
+	require_password_change_trigger = "onboarding"
+	password_version = "2"
+	unlock_trigger = "offboarding"
+}
+`
+
 func TestAccUser_LdapOrigin_normal(t *testing.T) {
 
 	ref := "uaa_user.manager1"
@@ -82,7 +134,7 @@ func TestAccUser_WithGroups_normal(t *testing.T) {
 					Check: resource.ComposeTestCheckFunc(
 						testAccCheckUserExists(ref, test.DefaultZoneId),
 						resource.TestCheckResourceAttr(ref, "name", username),
-						resource.TestCheckResourceAttr(ref, "password", "qwerty"),
+						resource.TestCheckResourceAttr(ref, "password", passwordHash("qwerty")),
 						resource.TestCheckResourceAttr(ref, "email", username),
 						resource.TestCheckResourceAttr(ref, "zone_id", test.DefaultZoneId),
 						util.TestCheckResourceSet(ref, "groups", []string{
@@ -98,7 +150,7 @@ func TestAccUser_WithGroups_normal(t *testing.T) {
 					Check: resource.ComposeTestCheckFunc(
 						testAccCheckUserExists(ref, test.UpdatedZoneId),
 						resource.TestCheckResourceAttr(ref, "name", "cf-admin"),
-						resource.TestCheckResourceAttr(ref, "password", "asdfg"),
+						resource.TestCheckResourceAttr(ref, "password", passwordHash("asdfg")),
 						resource.TestCheckResourceAttr(ref, "email", "cf-admin@acme.com"),
 						resource.TestCheckResourceAttr(ref, "zone_id", test.UpdatedZoneId),
 						util.TestCheckResourceSet(ref, "groups", []string{
@@ -109,10 +161,112 @@ func TestAccUser_WithGroups_normal(t *testing.T) {
 						}),
 					),
 				},
+				{
+					ResourceName:            ref,
+					ImportState:             true,
+					ImportStateIdFunc:       util.ImportStateIdFunc(ref, "name"),
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"password"},
+				},
 			},
 		})
 }
 
+func TestAccUser_ScimAttributes_normal(t *testing.T) {
+
+	ref := "uaa_user.contractor"
+	username := "contractor@acme.com"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testAccCheckUserDestroyed(username),
+			Steps: []resource.TestStep{
+				{
+					Config: userResourceWithScimAttributes,
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckUserExists(ref, test.DefaultZoneId),
+						resource.TestCheckResourceAttr(ref, "external_id", "c-1234"),
+						resource.TestCheckResourceAttr(ref, "display_name", "Jane Contractor"),
+						resource.TestCheckResourceAttr(ref, "email", username),
+						resource.TestCheckResourceAttr(ref, "emails.#", "2"),
+						resource.TestCheckTypeSetElemNestedAttrs(ref, "emails.*", map[string]string{
+							"value":   username,
+							"primary": "true",
+						}),
+						resource.TestCheckTypeSetElemNestedAttrs(ref, "emails.*", map[string]string{
+							"value":   "jane@contracting.com",
+							"primary": "false",
+						}),
+						resource.TestCheckResourceAttr(ref, "phone_numbers.0", "+31 20 123 4567"),
+						resource.TestCheckResourceAttr(ref, "active", "true"),
+						resource.TestCheckResourceAttr(ref, "verified", "true"),
+						resource.TestCheckResourceAttrSet(ref, "password_last_modified"),
+						resource.TestCheckResourceAttrSet(ref, "created"),
+						resource.TestCheckResourceAttrSet(ref, "last_modified"),
+					),
+				},
+				{
+					Config: userResourceWithScimAttributesDeactivated,
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckUserExists(ref, test.DefaultZoneId),
+						resource.TestCheckResourceAttr(ref, "active", "false"),
+						resource.TestCheckResourceAttr(ref, "verified", "false"),
+						resource.TestCheckResourceAttr(ref, "emails.#", "2"),
+						resource.TestCheckResourceAttr(ref, "require_password_change_trigger", "onboarding"),
+						resource.TestCheckResourceAttr(ref, "unlock_trigger", "offboarding"),
+						resource.TestCheckResourceAttr(ref, "password_version", "2"),
+					),
+				},
+				{
+					Config: strings.Replace(userResourceWithScimAttributesDeactivated, `require_password_change_trigger = "onboarding"`, "", 1),
+					Check:  resource.TestCheckResourceAttr(ref, "require_password_change_trigger", ""),
+				},
+			},
+		})
+}
+
+func TestAccUser_DeletedOutsideTerraform_normal(t *testing.T) {
+
+	ref := "uaa_user.manager1"
+	username := "manager1@acme.com"
+
+	resource.Test(t,
+		resource.TestCase{
+			PreCheck:          func() { util.VerifyEnvironmentVariablesAreSet(t) },
+			ProviderFactories: util.ProviderFactories,
+			CheckDestroy:      testAccCheckUserDestroyed(username),
+			Steps: []resource.TestStep{
+				{
+					Config: ldapUserResource,
+					Check:  testAccCheckUserExists(ref, test.DefaultZoneId),
+				},
+				{
+					// The user is only removed from state when UAA says it's gone, so it's recreated
+					PreConfig: func() {
+						um := util.UaaSession().UserManager()
+						user, err := um.FindByUsername(username, test.DefaultZoneId)
+						if err != nil {
+							t.Fatal(err)
+						}
+						if err := um.DeleteUser(user.Id, test.DefaultZoneId); err != nil {
+							t.Fatal(err)
+						}
+					},
+					Config: ldapUserResource,
+					Check:  testAccCheckUserExists(ref, test.DefaultZoneId),
+				},
+			},
+		})
+}
+
+// passwordHash is what the provider keeps in state instead of the password.
+func passwordHash(password string) string {
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
 func testAccCheckUserExists(resource, zoneId string) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -151,7 +305,13 @@ func testAccCheckUserExists(resource, zoneId string) resource.TestCheckFunc {
 		if err := util.AssertEquals(attributes, "family_name", user.Name.FamilyName); err != nil {
 			return err
 		}
-		if err := util.AssertEquals(attributes, "email", user.Emails[0].Value); err != nil {
+		email := user.Emails[0].Value
+		for _, e := range user.Emails {
+			if e.Primary {
+				email = e.Value
+			}
+		}
+		if err := util.AssertEquals(attributes, "email", email); err != nil {
 			return err
 		}
 
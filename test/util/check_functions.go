@@ -3,6 +3,7 @@ package util
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestCheckResourceSet(ref string, attr string, values []string) resource.TestCheckFunc {
@@ -16,3 +17,14 @@ func TestCheckResourceSet(ref string, attr string, values []string) resource.Tes
 
 	return resource.ComposeTestCheckFunc(lTests...)
 }
+
+// ImportStateIdFunc builds the `<zone_id>/<name>` ID used to import a resource from the resource's state.
+func ImportStateIdFunc(ref string, nameAttr string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[ref]
+		if !ok {
+			return "", fmt.Errorf("resource '%s' not found in terraform state", ref)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["zone_id"], rs.Primary.Attributes[nameAttr]), nil
+	}
+}
@@ -3,12 +3,14 @@ package api
 import (
 	"bytes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/net"
 	"encoding/json"
 	"errors"
 	"fmt"
 	apiheaders "github.com/foundcloudry/terraform-provider-uaa/uaa/api/headers"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -17,10 +19,21 @@ type UaaApi struct {
 	baseUrl           string
 	config            coreconfig.Reader
 	gateway           net.Gateway
+	options           *apiOptions
 	zoneId            string
 }
 
-func newUaaApi(config coreconfig.Reader, gateway net.Gateway) (*UaaApi, error) {
+// apiOptions holds the settings shared by every UaaApi instance created for a session.
+type apiOptions struct {
+	forceOverwrite bool
+	homeZoneId     string
+	log            *Logger
+	pageSize       int
+	retryPolicy    *RetryPolicy
+	tokenRefresher *tokenRefresher
+}
+
+func newUaaApi(config coreconfig.Reader, gateway net.Gateway, options *apiOptions) (*UaaApi, error) {
 	if config.UaaEndpoint() == "" {
 		return nil, errors.New("no UAA endpoint provided when instantiating the UAA API")
 	}
@@ -30,6 +43,7 @@ func newUaaApi(config coreconfig.Reader, gateway net.Gateway) (*UaaApi, error) {
 		baseUrl:           config.UaaEndpoint(),
 		config:            config,
 		gateway:           gateway,
+		options:           options,
 	}, nil
 }
 
@@ -48,6 +62,7 @@ func (api *UaaApi) WithHeaders(headers map[string]string) *UaaApi {
 		baseUrl:           api.baseUrl,
 		config:            api.config,
 		gateway:           api.gateway,
+		options:           api.options,
 		zoneId:            api.zoneId,
 	}
 }
@@ -58,10 +73,31 @@ func (api *UaaApi) WithZoneId(zoneId string) *UaaApi {
 		baseUrl:           api.baseUrl,
 		config:            api.config,
 		gateway:           api.gateway,
+		options:           api.options,
 		zoneId:            zoneId,
 	}
 }
 
+// WithVersion makes updates conditional on the resource still being at the given SCIM version, unless the session is
+// configured to overwrite concurrent changes.
+func (api *UaaApi) WithVersion(version int) *UaaApi {
+
+	ifMatch := strconv.Itoa(version)
+	if api.options != nil && api.options.forceOverwrite {
+		ifMatch = "*"
+	}
+	return api.WithHeaders(map[string]string{
+		apiheaders.IfMatch.String(): ifMatch,
+	})
+}
+
+func (api *UaaApi) pageSize() int {
+	if api.options == nil || api.options.pageSize <= 0 {
+		return DefaultPageSize
+	}
+	return api.options.pageSize
+}
+
 func (api *UaaApi) newRequest(method, path string, body any, responseBody any) error {
 
 	path = strings.Replace(path, "//", "/", -1)
@@ -72,29 +108,70 @@ func (api *UaaApi) newRequest(method, path string, body any, responseBody any) e
 		return err
 	}
 
+	accessToken, err := api.accessToken()
+	if err != nil {
+		return err
+	}
+
+	request, err := api.performRequest(method, path, accessToken, jsonBody, responseBody)
+	if isTokenRejected(err) && api.options != nil && api.options.tokenRefresher != nil {
+		// The token was revoked or expired early; re-authenticate and retry the request once
+		rejectedToken := request.HTTPReq.Header.Get(apiheaders.Authorization.String())
+		if accessToken, err = api.options.tokenRefresher.ForceRefresh(rejectedToken); err != nil {
+			return err
+		}
+		_, err = api.performRequest(method, path, accessToken, jsonBody, responseBody)
+	}
+
+	return err
+}
+
+func (api *UaaApi) performRequest(method, path, accessToken string, jsonBody []byte, responseBody any) (*net.Request, error) {
+
 	request, err := api.gateway.NewRequest(
 		method,
 		fmt.Sprintf("%s/%s", api.baseUrl, path),
-		api.config.AccessToken(),
+		accessToken,
 		bytes.NewReader(jsonBody),
 	)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	request.HTTPReq.Header.Set(apiheaders.ZoneId.String(), api.zoneId)
+	// Requests for the zone the session authenticated into don't need to switch zones, which zone admins can't do
+	if api.zoneId != "" && (api.options == nil || api.zoneId != api.options.homeZoneId) {
+		request.HTTPReq.Header.Set(apiheaders.ZoneId.String(), api.zoneId)
+	}
 	for i, v := range api.additionalHeaders {
 		request.HTTPReq.Header.Del(i)
 		request.HTTPReq.Header.Set(i, v)
 	}
 
-	_, err = api.gateway.PerformRequestForJSONResponse(request, &responseBody)
-	if err != nil {
-		return err
+	var retryPolicy *RetryPolicy
+	var log *Logger
+	if api.options != nil {
+		retryPolicy, log = api.options.retryPolicy, api.options.log
 	}
 
-	return nil
+	err = retryPolicy.do(log, method, func() (*http.Response, error) {
+		return performJSONRequest(api.gateway, request, &responseBody)
+	})
+	return request, err
+}
+
+func (api *UaaApi) accessToken() (string, error) {
+	if api.options == nil || api.options.tokenRefresher == nil {
+		return api.config.AccessToken(), nil
+	}
+	return api.options.tokenRefresher.AccessToken()
+}
+
+func isTokenRejected(err error) bool {
+	if _, ok := err.(*cferrors.InvalidTokenError); ok {
+		return true
+	}
+	return statusCode(err) == http.StatusUnauthorized
 }
 
 func (api *UaaApi) Get(path string, responseBody any) error {
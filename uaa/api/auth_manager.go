@@ -22,9 +22,11 @@ var errPreventRedirect = errors.New("prevent-redirect")
 
 // AuthManager -
 type AuthManager struct {
-	config  coreconfig.ReadWriter
-	gateway net.Gateway
-	dumper  net.RequestDumper
+	config      coreconfig.ReadWriter
+	gateway     net.Gateway
+	dumper      net.RequestDumper
+	log         *Logger
+	retryPolicy *RetryPolicy
 }
 
 // authenticationResponse -
@@ -44,6 +46,12 @@ func NewAuthManager(gateway net.Gateway, config coreconfig.ReadWriter, dumper ne
 	}
 }
 
+// SetRetryPolicy sets the policy used to retry token requests that fail transiently.
+func (tm *AuthManager) SetRetryPolicy(retryPolicy *RetryPolicy, logger *Logger) {
+	tm.retryPolicy = retryPolicy
+	tm.log = logger
+}
+
 // DumpRequest -
 func (tm *AuthManager) DumpRequest(req *http.Request) {
 	tm.dumper.DumpRequest(req)
@@ -155,6 +163,34 @@ func (tm *AuthManager) GetClientToken(clientID, clientSecret, subDomain string)
 		"grant_type": {"client_credentials"},
 	}
 
+	return tm.getToken(clientID, clientSecret, subDomain, data)
+}
+
+// GetPasswordToken obtains a token for a user with the password grant.
+func (tm *AuthManager) GetPasswordToken(clientID, clientSecret, username, password, subDomain string) (string, error) {
+
+	data := url.Values{
+		"grant_type": {"password"},
+		"username":   {username},
+		"password":   {password},
+	}
+
+	return tm.getToken(clientID, clientSecret, subDomain, data)
+}
+
+// GetJwtBearerToken exchanges a JWT assertion issued by a trusted identity provider for a token.
+func (tm *AuthManager) GetJwtBearerToken(clientID, clientSecret, assertion, subDomain string) (string, error) {
+
+	data := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+
+	return tm.getToken(clientID, clientSecret, subDomain, data)
+}
+
+func (tm *AuthManager) getToken(clientID, clientSecret, subDomain string, data url.Values) (token string, err error) {
+
 	response, err := tm.getAuthToken(clientID, clientSecret, subDomain, data)
 	if err != nil {
 		httpError, ok := err.(errors.HTTPError)
@@ -169,9 +205,9 @@ func (tm *AuthManager) GetClientToken(clientID, clientSecret, subDomain string)
 		return
 	}
 
-	clientToken = fmt.Sprintf("%s %s", response.TokenType, response.AccessToken)
+	token = fmt.Sprintf("%s %s", response.TokenType, response.AccessToken)
 
-	tm.config.SetAccessToken(clientToken)
+	tm.config.SetAccessToken(token)
 	tm.config.SetRefreshToken(response.RefreshToken)
 	return
 }
@@ -197,11 +233,7 @@ func (tm *AuthManager) RefreshToken() (string, error) {
 
 func (tm *AuthManager) getAuthToken(clientID, clientSecret, subDomain string, data url.Values) (*authenticationResponse, error) {
 
-	authUrl := tm.config.AuthenticationEndpoint()
-	if subDomain != "" {
-		authUrl = strings.Replace(authUrl, "http://", "http://"+subDomain+".", 1)
-		authUrl = strings.Replace(authUrl, "https://", "https://"+subDomain+".", 1)
-	}
+	authUrl := endpointWithSubDomain(tm.config.AuthenticationEndpoint(), subDomain)
 
 	path := fmt.Sprintf("%s/oauth/token", authUrl)
 	request, err := tm.gateway.NewRequest("POST", path,
@@ -213,7 +245,9 @@ func (tm *AuthManager) getAuthToken(clientID, clientSecret, subDomain string, da
 	request.HTTPReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	response := new(authenticationResponse)
-	_, err = tm.gateway.PerformRequestForJSONResponse(request, &response)
+	err = tm.retryPolicy.do(tm.log, request.HTTPReq.Method, func() (*http.Response, error) {
+		return performJSONRequest(tm.gateway, request, &response)
+	})
 
 	switch err.(type) {
 	case nil:
@@ -1,43 +1,85 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
-	"net/url"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/net"
 )
 
+const changeModeAdd = "ADD"
+const changeModeDelete = "DELETE"
+const changeModeUpdate = "UPDATE"
+
 type ClientManager struct {
 	log *Logger
 	api *UaaApi
 }
 
 type UAAClient struct {
-	ClientID             string   `json:"client_id,omitempty"`
-	ClientSecret         string   `json:"client_secret,omitempty"`
-	AuthorizedGrantTypes []string `json:"authorized_grant_types,omitempty"`
-	RedirectURI          []string `json:"redirect_uri,omitempty"`
-	Scope                []string `json:"scope,omitempty"`
-	ResourceIds          []string `json:"resource_ids,omitempty"`
-	Authorities          []string `json:"authorities,omitempty"`
-	AutoApprove          []string `json:"autoapprove,omitempty"`
-	AccessTokenValidity  int      `json:"access_token_validity,omitempty"`
-	RefreshTokenValidity int      `json:"refresh_token_validity,omitempty"`
-	AllowedProviders     []string `json:"allowedproviders,omitempty"`
-	Name                 string   `json:"name,omitempty"`
-	TokenSalt            string   `json:"token_salt,omitempty"`
-	CreatedWith          string   `json:"createdwith,omitempty"`
-	ApprovalsDeleted     bool     `json:"approvals_deleted,omitempty"`
-	RequiredUserGroups   []string `json:"required_user_groups,omitempty"`
-	LastModified         int64    `json:"lastModified,omitempty"`
-}
-
-// UAAClientResourceList -
-type UAAClientResourceList struct {
-	Resources []UAAClient `json:"resources"`
+	ClientID             string          `json:"client_id,omitempty"`
+	ClientSecret         string          `json:"client_secret,omitempty"`
+	AuthorizedGrantTypes []string        `json:"authorized_grant_types,omitempty"`
+	RedirectURI          []string        `json:"redirect_uri,omitempty"`
+	Scope                []string        `json:"scope,omitempty"`
+	ResourceIds          []string        `json:"resource_ids,omitempty"`
+	Authorities          []string        `json:"authorities,omitempty"`
+	AutoApprove          []string        `json:"autoapprove,omitempty"`
+	AccessTokenValidity  int             `json:"access_token_validity,omitempty"`
+	RefreshTokenValidity int             `json:"refresh_token_validity,omitempty"`
+	AllowedProviders     []string        `json:"allowedproviders,omitempty"`
+	Name                 string          `json:"name,omitempty"`
+	TokenSalt            string          `json:"token_salt,omitempty"`
+	CreatedWith          string          `json:"createdwith,omitempty"`
+	ApprovalsDeleted     bool            `json:"approvals_deleted,omitempty"`
+	RequiredUserGroups   []string        `json:"required_user_groups,omitempty"`
+	LastModified         int64           `json:"lastModified,omitempty"`
+	JwksUri              string          `json:"jwks_uri,omitempty"`
+	Jwks                 json.RawMessage `json:"jwks,omitempty"`
+	ClientJwtConfig      json.RawMessage `json:"client_jwt_config,omitempty"`
+}
+
+// UAAClientJwtConfig is the configuration of a client that authenticates with a signed JWT (`private_key_jwt`) rather
+// than a secret.
+type UAAClientJwtConfig struct {
+	JwksUri  string                   `json:"jwks_uri,omitempty"`
+	Jwks     json.RawMessage          `json:"jwks,omitempty"`
+	JwtCreds []UAAClientJwtCredential `json:"jwt_creds,omitempty"`
+}
+
+// UAAClientJwtCredential trusts JWTs issued by another party, e.g. a workload identity provider, as the client's
+// credentials.
+type UAAClientJwtCredential struct {
+	Issuer   string `json:"iss,omitempty"`
+	Subject  string `json:"sub,omitempty"`
+	Audience string `json:"aud,omitempty"`
+}
+
+// UAAClientMeta is how the client is shown on the login page's app launcher.  The icon is a base64 encoded image.
+type UAAClientMeta struct {
+	ClientId       string `json:"clientId,omitempty"`
+	ShowOnHomePage bool   `json:"showOnHomePage"`
+	AppLaunchUrl   string `json:"appLaunchUrl"`
+	AppIcon        string `json:"appIcon"`
+	ClientName     string `json:"clientName"`
+}
+
+// IsEmpty reports whether the client has no app launcher metadata.
+func (m *UAAClientMeta) IsEmpty() bool {
+	return !m.ShowOnHomePage && m.AppLaunchUrl == "" && m.AppIcon == "" && m.ClientName == ""
+}
+
+type clientJwtChange struct {
+	ClientId   string          `json:"client_id"`
+	ChangeMode string          `json:"changeMode"`
+	JwksUri    string          `json:"jwks_uri,omitempty"`
+	Jwks       json.RawMessage `json:"jwks,omitempty"`
+	Issuer     string          `json:"iss,omitempty"`
+	Subject    string          `json:"sub,omitempty"`
+	Audience   string          `json:"aud,omitempty"`
 }
 
 func (c *UAAClient) HasDefaultScope() bool {
@@ -52,9 +94,33 @@ func (c *UAAClient) HasDefaultResourceIds() bool {
 	return len(c.ResourceIds) == 1 && c.ResourceIds[0] == "none"
 }
 
-func newClientManager(config coreconfig.Reader, gateway net.Gateway, logger *Logger) (cm *ClientManager, err error) {
+// JwtConfig returns the client's JWT configuration, or nil if the client doesn't authenticate with JWTs.  UAA keeps the
+// configuration as a JSON document in a string, so it's decoded from either form.
+func (c *UAAClient) JwtConfig() (*UAAClientJwtConfig, error) {
 
-	api, err := newUaaApi(config, gateway)
+	raw := c.ClientJwtConfig
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var document string
+	if err := json.Unmarshal(raw, &document); err == nil {
+		if document == "" {
+			return nil, nil
+		}
+		raw = json.RawMessage(document)
+	}
+
+	config := &UAAClientJwtConfig{}
+	if err := json.Unmarshal(raw, config); err != nil {
+		return nil, fmt.Errorf("invalid JWT configuration for client '%s': %s", c.ClientID, err.Error())
+	}
+	return config, nil
+}
+
+func newClientManager(config coreconfig.Reader, gateway net.Gateway, options *apiOptions, logger *Logger) (cm *ClientManager, err error) {
+
+	api, err := newUaaApi(config, gateway, options)
 	if err != nil {
 		return
 	}
@@ -76,6 +142,27 @@ func (manager *ClientManager) GetClient(id, zoneId string) (client *UAAClient, e
 	return
 }
 
+func (manager *ClientManager) GetClientMeta(id, zoneId string) (meta *UAAClientMeta, err error) {
+
+	path := fmt.Sprintf("/oauth/clients/%s/meta", id)
+	meta = &UAAClientMeta{}
+	err = manager.api.
+		WithZoneId(zoneId).
+		Get(path, meta)
+	return
+}
+
+func (manager *ClientManager) UpdateClientMeta(id string, meta UAAClientMeta, zoneId string) (updated *UAAClientMeta, err error) {
+
+	meta.ClientId = id
+	path := fmt.Sprintf("/oauth/clients/%s/meta", id)
+	updated = &UAAClientMeta{}
+	err = manager.api.
+		WithZoneId(zoneId).
+		Put(path, meta, updated)
+	return
+}
+
 func (manager *ClientManager) Create(newClient UAAClient, zoneId string) (client UAAClient, err error) {
 
 	err = manager.api.
@@ -90,14 +177,24 @@ func (manager *ClientManager) Create(newClient UAAClient, zoneId string) (client
 	return
 }
 
+// UpdateClient merges the client into the one UAA currently has, so that additional information the provider doesn't
+// model is kept.
 func (manager *ClientManager) UpdateClient(updatedClient *UAAClient, zoneId string) (client UAAClient, err error) {
 
 	path := fmt.Sprintf("/oauth/clients/%s", updatedClient.ClientID)
-	if err := manager.api.
-		WithZoneId(zoneId).
-		Put(path, updatedClient, &client); err != nil {
-		return client, err
+	uaaApi := manager.api.WithZoneId(zoneId)
+
+	current := json.RawMessage{}
+	if err = uaaApi.Get(path, &current); err != nil {
+		return
+	}
+
+	merged, err := mergeManagedFields(current, updatedClient)
+	if err != nil {
+		return
 	}
+
+	err = uaaApi.Put(path, merged, &client)
 	return
 }
 
@@ -119,31 +216,124 @@ func (manager *ClientManager) ChangeSecret(id, oldSecret, newSecret, zoneId stri
 		data["oldSecret"] = oldSecret
 	}
 
-	path := fmt.Sprintf("/oauth/clients/%s/secret", id)
+	return manager.changeSecret(id, data, zoneId)
+}
+
+// AddSecret gives the client a second secret, so that both the current and the new secret are accepted while the
+// client's consumers are moved over.  UAA allows at most two secrets per client.
+func (manager *ClientManager) AddSecret(id, secret, zoneId string) (err error) {
+
+	data := map[string]string{
+		"clientId":   id,
+		"secret":     secret,
+		"changeMode": changeModeAdd,
+	}
+
+	return manager.changeSecret(id, data, zoneId)
+}
+
+// DeleteOldSecret removes the older of the client's two secrets, leaving only the one added by AddSecret.
+func (manager *ClientManager) DeleteOldSecret(id, zoneId string) (err error) {
+
+	data := map[string]string{
+		"clientId":   id,
+		"changeMode": changeModeDelete,
+	}
+
+	return manager.changeSecret(id, data, zoneId)
+}
+
+// UpdateClientJwt replaces the keys that the client's JWTs are verified with by the key set at jwksUri, or the inline
+// key set jwks.
+func (manager *ClientManager) UpdateClientJwt(id, jwksUri, jwks, zoneId string) (err error) {
+
+	return manager.changeClientJwt(id, clientJwtChange{
+		ChangeMode: changeModeUpdate,
+		JwksUri:    jwksUri,
+		Jwks:       rawJson(jwks),
+	}, zoneId)
+}
+
+// DeleteClientJwt removes the key set URI, or the keys of the inline key set, that the client's JWTs are verified with.
+func (manager *ClientManager) DeleteClientJwt(id, jwksUri, jwks, zoneId string) (err error) {
+
+	return manager.changeClientJwt(id, clientJwtChange{
+		ChangeMode: changeModeDelete,
+		JwksUri:    jwksUri,
+		Jwks:       rawJson(jwks),
+	}, zoneId)
+}
+
+func (manager *ClientManager) AddClientJwtCredential(id string, credential UAAClientJwtCredential, zoneId string) (err error) {
+
+	return manager.changeClientJwt(id, clientJwtChange{
+		ChangeMode: changeModeAdd,
+		Issuer:     credential.Issuer,
+		Subject:    credential.Subject,
+		Audience:   credential.Audience,
+	}, zoneId)
+}
+
+func (manager *ClientManager) DeleteClientJwtCredential(id string, credential UAAClientJwtCredential, zoneId string) (err error) {
+
+	return manager.changeClientJwt(id, clientJwtChange{
+		ChangeMode: changeModeDelete,
+		Issuer:     credential.Issuer,
+		Subject:    credential.Subject,
+		Audience:   credential.Audience,
+	}, zoneId)
+}
+
+func (manager *ClientManager) changeClientJwt(id string, change clientJwtChange, zoneId string) (err error) {
+
+	change.ClientId = id
+	path := fmt.Sprintf("/oauth/clients/%s/clientjwt", id)
 	response := make(map[string]interface{})
 
 	err = manager.api.
 		WithZoneId(zoneId).
-		Put(path, data, &response)
+		Put(path, change, &response)
 	return
 }
 
-func (manager *ClientManager) FindByClientID(clientID, zoneId string) (client UAAClient, err error) {
+func (manager *ClientManager) changeSecret(id string, data map[string]string, zoneId string) (err error) {
 
-	filter := url.QueryEscape(fmt.Sprintf(`client_id Eq "%s"`, clientID))
-	path := fmt.Sprintf("/oauth/clients?filter=%s", filter)
+	path := fmt.Sprintf("/oauth/clients/%s/secret", id)
+	response := make(map[string]interface{})
 
-	clientResourceList := &UAAClientResourceList{}
 	err = manager.api.
 		WithZoneId(zoneId).
-		Get(path, clientResourceList)
+		Put(path, data, &response)
+	return
+}
+
+// List returns the clients matching the options, reading every page of results.
+func (manager *ClientManager) List(options ListOptions, zoneId string) ([]UAAClient, error) {
+	return listAll[UAAClient](manager.api.WithZoneId(zoneId), "/oauth/clients", options.query())
+}
+
+func (manager *ClientManager) FindByClientID(clientID, zoneId string) (client UAAClient, err error) {
+
+	clients, err := listAll[UAAClient](
+		manager.api.WithZoneId(zoneId),
+		"/oauth/clients",
+		filterQuery(`client_id Eq "%s"`, clientID),
+	)
 
 	if err == nil {
-		if len(clientResourceList.Resources) > 0 {
-			client = clientResourceList.Resources[0]
+		if len(clients) > 0 {
+			client = clients[0]
 		} else {
 			err = errors.NewModelNotFoundError("Client", clientID)
 		}
 	}
 	return
 }
+
+// rawJson returns the JSON document, or nil so that an empty document is omitted.
+func rawJson(document string) json.RawMessage {
+	if document == "" {
+		return nil
+	}
+	return json.RawMessage(document)
+}
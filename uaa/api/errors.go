@@ -0,0 +1,56 @@
+package api
+
+import (
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
+	"errors"
+	"net/http"
+)
+
+// IsNotFound reports whether the error means the resource doesn't exist, either because UAA responded with a 404 or
+// because a lookup by name found no match.
+func IsNotFound(err error) bool {
+
+	var notFound *cferrors.ModelNotFoundError
+	if errors.As(err, &notFound) {
+		return true
+	}
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsConflict reports whether the error means the resource already exists, or was changed since the version that an
+// update was based on.
+func IsConflict(err error) bool {
+
+	var alreadyExists *cferrors.ModelAlreadyExistsError
+	if errors.As(err, &alreadyExists) {
+		return true
+	}
+	code := statusCode(err)
+	return code == http.StatusConflict || code == http.StatusPreconditionFailed
+}
+
+// IsUnauthorized reports whether the error means the session's token was rejected or lacks the scopes for the request.
+func IsUnauthorized(err error) bool {
+
+	var invalidToken *cferrors.InvalidTokenError
+	if errors.As(err, &invalidToken) {
+		return true
+	}
+	code := statusCode(err)
+	return code == http.StatusUnauthorized || code == http.StatusForbidden
+}
+
+// IsServerError reports whether the error means UAA failed to handle the request.
+func IsServerError(err error) bool {
+	return statusCode(err) >= http.StatusInternalServerError
+}
+
+// statusCode returns the HTTP status code of the response that caused the error, or 0 if there was none.
+func statusCode(err error) int {
+
+	var httpErr cferrors.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode()
+	}
+	return 0
+}
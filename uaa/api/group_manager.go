@@ -5,7 +5,6 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/net"
 	"fmt"
-	apiheaders "github.com/foundcloudry/terraform-provider-uaa/uaa/api/headers"
 	"net/http"
 	"net/url"
 )
@@ -16,19 +15,29 @@ type GroupManager struct {
 }
 
 type UAAGroup struct {
-	Id          string `json:"id,omitempty"`
-	DisplayName string `json:"displayName,omitempty"`
-	Description string `json:"description,omitempty"`
-	ZoneId      string `json:"zoneId,omitempty"`
+	Id          string   `json:"id,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Description string   `json:"description,omitempty"`
+	ZoneId      string   `json:"zoneId,omitempty"`
+	Meta        *UAAMeta `json:"meta,omitempty"`
 }
 
-type UAAGroupResourceList struct {
-	Resources []UAAGroup `json:"resources"`
+type UAAGroupMapping struct {
+	GroupId       string `json:"groupId,omitempty"`
+	DisplayName   string `json:"displayName,omitempty"`
+	ExternalGroup string `json:"externalGroup"`
+	Origin        string `json:"origin,omitempty"`
 }
 
-func newGroupManager(config coreconfig.Reader, gateway net.Gateway, logger *Logger) (gm *GroupManager, err error) {
+type UAAGroupMember struct {
+	Origin string `json:"origin,omitempty"`
+	Type   string `json:"type"`
+	Value  string `json:"value"`
+}
+
+func newGroupManager(config coreconfig.Reader, gateway net.Gateway, options *apiOptions, logger *Logger) (gm *GroupManager, err error) {
 
-	api, err := newUaaApi(config, gateway)
+	api, err := newUaaApi(config, gateway, options)
 	if err != nil {
 		return
 	}
@@ -75,7 +84,7 @@ func (manager *GroupManager) GetGroup(id, zoneId string) (group *UAAGroup, err e
 	return
 }
 
-func (manager *GroupManager) UpdateGroup(id, displayName, description, zoneId string) (group *UAAGroup, err error) {
+func (manager *GroupManager) UpdateGroup(id, displayName, description string, version int, zoneId string) (group *UAAGroup, err error) {
 
 	groupResource := UAAGroup{
 		DisplayName: displayName,
@@ -85,9 +94,7 @@ func (manager *GroupManager) UpdateGroup(id, displayName, description, zoneId st
 	path := fmt.Sprintf("/Groups/%s", id)
 	err = manager.api.
 		WithZoneId(zoneId).
-		WithHeaders(map[string]string{
-			apiheaders.IfMatch.String(): "*",
-		}).
+		WithVersion(version).
 		Put(path, groupResource, &group)
 
 	return
@@ -101,20 +108,85 @@ func (manager *GroupManager) DeleteGroup(id, zoneId string) error {
 
 func (manager *GroupManager) FindByDisplayName(displayName, zoneId string) (group *UAAGroup, err error) {
 
-	displayNameFilter := url.QueryEscape(fmt.Sprintf(`displayName Eq "%s"`, displayName))
-	path := fmt.Sprintf("/Groups?filter=%s", displayNameFilter)
-
-	groupResourceList := &UAAGroupResourceList{}
-	err = manager.api.
-		WithZoneId(zoneId).
-		Get(path, groupResourceList)
+	groups, err := listAll[UAAGroup](
+		manager.api.WithZoneId(zoneId),
+		"/Groups",
+		filterQuery(`displayName Eq "%s"`, displayName),
+	)
 
 	if err == nil {
-		if len(groupResourceList.Resources) > 0 {
-			group = &groupResourceList.Resources[0]
+		if len(groups) > 0 {
+			group = &groups[0]
 		} else {
 			err = errors.NewModelNotFoundError("Group", displayName)
 		}
 	}
 	return
 }
+
+// List returns the groups matching the options, reading every page of results.
+func (manager *GroupManager) List(options ListOptions, zoneId string) ([]UAAGroup, error) {
+	return listAll[UAAGroup](manager.api.WithZoneId(zoneId), "/Groups", options.query())
+}
+
+func (manager *GroupManager) GetMembers(groupId, zoneId string) (members []UAAGroupMember, err error) {
+
+	path := fmt.Sprintf("/Groups/%s/members", groupId)
+	err = manager.api.
+		WithZoneId(zoneId).
+		Get(path, &members)
+
+	return
+}
+
+func (manager *GroupManager) AddMember(groupId string, member UAAGroupMember, zoneId string) (added *UAAGroupMember, err error) {
+
+	path := fmt.Sprintf("/Groups/%s/members", groupId)
+	err = manager.api.
+		WithZoneId(zoneId).
+		Post(path, member, &added)
+
+	return
+}
+
+func (manager *GroupManager) RemoveMember(groupId, memberId, zoneId string) error {
+
+	path := fmt.Sprintf("/Groups/%s/members/%s", groupId, memberId)
+	return manager.api.WithZoneId(zoneId).Delete(path)
+}
+
+func (manager *GroupManager) MapExternalGroup(mapping UAAGroupMapping, zoneId string) (created *UAAGroupMapping, err error) {
+
+	err = manager.api.
+		WithZoneId(zoneId).
+		Post("/Groups/External", mapping, &created)
+
+	return
+}
+
+func (manager *GroupManager) FindExternalGroupMapping(groupId, externalGroup, origin, zoneId string) (*UAAGroupMapping, error) {
+
+	mappings, err := listAll[UAAGroupMapping](manager.api.WithZoneId(zoneId), "/Groups/External", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range mappings {
+		if m.GroupId == groupId && m.ExternalGroup == externalGroup && m.Origin == origin {
+			return &m, nil
+		}
+	}
+
+	return nil, errors.NewModelNotFoundError("Group mapping", fmt.Sprintf("%s (%s)", externalGroup, origin))
+}
+
+func (manager *GroupManager) UnmapExternalGroup(groupId, externalGroup, origin, zoneId string) error {
+
+	path := fmt.Sprintf(
+		"/Groups/External/groupId/%s/externalGroup/%s/origin/%s",
+		groupId,
+		url.PathEscape(externalGroup),
+		url.PathEscape(origin),
+	)
+	return manager.api.WithZoneId(zoneId).Delete(path)
+}
@@ -0,0 +1,318 @@
+package api
+
+import (
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/net"
+	"encoding/json"
+	"fmt"
+)
+
+// The `rawConfig` query parameter makes UAA return the provider config as a JSON object rather than as an escaped
+// JSON string.
+const identityProvidersPath = "/identity-providers"
+const rawConfigQuery = "rawConfig=true"
+
+// The internal provider that holds the zone's users and its password rules
+const uaaOriginKey = "uaa"
+
+type IdentityProviderManager struct {
+	log *Logger
+	api *UaaApi
+}
+
+func newIdentityProviderManager(config coreconfig.Reader, gateway net.Gateway, options *apiOptions, logger *Logger) (ipm *IdentityProviderManager, err error) {
+
+	api, err := newUaaApi(config, gateway, options)
+	if err != nil {
+		return
+	}
+
+	ipm = &IdentityProviderManager{
+		log: logger,
+		api: api,
+	}
+	return
+}
+
+// CRUD methods
+
+func (manager *IdentityProviderManager) Create(identityProvider *IdentityProvider, zoneId string) (*IdentityProvider, error) {
+
+	path := fmt.Sprintf("%s?%s", identityProvidersPath, rawConfigQuery)
+	if err := manager.api.WithZoneId(zoneId).Post(path, identityProvider, &identityProvider); err != nil {
+		return nil, err
+	}
+
+	return identityProvider, nil
+}
+
+func (manager *IdentityProviderManager) FindById(id, zoneId string) (*IdentityProvider, error) {
+
+	path := fmt.Sprintf("%s/%s?%s", identityProvidersPath, id, rawConfigQuery)
+	identityProvider := &IdentityProvider{}
+	if err := manager.api.WithZoneId(zoneId).Get(path, identityProvider); err != nil {
+		return nil, err
+	}
+
+	return identityProvider, nil
+}
+
+func (manager *IdentityProviderManager) FindByOriginKey(originKey, zoneId string) (*IdentityProvider, error) {
+
+	path := fmt.Sprintf("%s?%s", identityProvidersPath, rawConfigQuery)
+	identityProviders := &[]IdentityProvider{}
+	if err := manager.api.WithZoneId(zoneId).Get(path, identityProviders); err != nil {
+		return nil, err
+	}
+
+	for _, identityProvider := range *identityProviders {
+		if identityProvider.OriginKey == originKey {
+			return &identityProvider, nil
+		}
+	}
+
+	return nil, errors.NewModelNotFoundError("Identity Provider", originKey)
+}
+
+// Update replaces the identity provider.  UAA checks the version of the provider that is sent against the one it holds,
+// and the version isn't kept in state, so the provider is read first to update its current version.
+func (manager *IdentityProviderManager) Update(id string, identityProvider *IdentityProvider, zoneId string) (*IdentityProvider, error) {
+
+	current, err := manager.FindById(id, zoneId)
+	if err != nil {
+		return nil, err
+	}
+	identityProvider.Version = current.Version
+
+	path := fmt.Sprintf("%s/%s?%s", identityProvidersPath, id, rawConfigQuery)
+	if err := manager.api.WithZoneId(zoneId).Put(path, identityProvider, &identityProvider); err != nil {
+		return nil, err
+	}
+
+	return identityProvider, nil
+}
+
+func (manager *IdentityProviderManager) Delete(id, zoneId string) error {
+
+	return manager.api.WithZoneId(zoneId).Delete(fmt.Sprintf("%s/%s", identityProvidersPath, id))
+}
+
+// GetUaaPolicies returns the password and lockout policies of the zone's internal `uaa` provider.  A nil policy means
+// the zone uses UAA's defaults.
+func (manager *IdentityProviderManager) GetUaaPolicies(zoneId string) (*UaaProviderPolicies, error) {
+
+	_, config, err := manager.findRawUaaProvider(zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := &UaaProviderPolicies{}
+	if err := unmarshalRawField(config, "passwordPolicy", &policies.PasswordPolicy); err != nil {
+		return nil, err
+	}
+	if err := unmarshalRawField(config, "lockoutPolicy", &policies.LockoutPolicy); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// UpdateUaaPolicies sets the password and lockout policies of the zone's internal `uaa` provider.  The rest of the
+// provider's config, and any policy settings that aren't managed here such as `passwordNewerThan`, are sent back as
+// they were read.  A nil policy is removed from the config, which makes the zone fall back to UAA's defaults.
+func (manager *IdentityProviderManager) UpdateUaaPolicies(policies UaaProviderPolicies, zoneId string) (*UaaProviderPolicies, error) {
+
+	provider, config, err := manager.findRawUaaProvider(zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mergeRawField(config, "passwordPolicy", policies.PasswordPolicy); err != nil {
+		return nil, err
+	}
+	if err := mergeRawField(config, "lockoutPolicy", policies.LockoutPolicy); err != nil {
+		return nil, err
+	}
+	if err := setRawField(provider, "config", config); err != nil {
+		return nil, err
+	}
+
+	var id string
+	if err := json.Unmarshal(provider["id"], &id); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%s?%s", identityProvidersPath, id, rawConfigQuery)
+	if err := manager.api.WithZoneId(zoneId).Put(path, provider, &provider); err != nil {
+		return nil, err
+	}
+
+	return manager.GetUaaPolicies(zoneId)
+}
+
+// findRawUaaProvider returns the zone's internal `uaa` provider and its config as raw JSON fields.
+func (manager *IdentityProviderManager) findRawUaaProvider(zoneId string) (provider, config map[string]json.RawMessage, err error) {
+
+	path := fmt.Sprintf("%s?%s", identityProvidersPath, rawConfigQuery)
+	var identityProviders []map[string]json.RawMessage
+	if err = manager.api.WithZoneId(zoneId).Get(path, &identityProviders); err != nil {
+		return
+	}
+
+	for _, p := range identityProviders {
+		var originKey string
+		if err = json.Unmarshal(p["originKey"], &originKey); err == nil && originKey == uaaOriginKey {
+			provider = p
+			config = map[string]json.RawMessage{}
+			err = unmarshalRawField(provider, "config", &config)
+			return
+		}
+	}
+
+	return nil, nil, errors.NewModelNotFoundError("Identity Provider", uaaOriginKey)
+}
+
+func unmarshalRawField(fields map[string]json.RawMessage, name string, value any) error {
+
+	raw, ok := fields[name]
+	if !ok || string(raw) == "null" {
+		return nil
+	}
+	return json.Unmarshal(raw, value)
+}
+
+func setRawField(fields map[string]json.RawMessage, name string, value any) error {
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if string(raw) == "null" {
+		delete(fields, name)
+	} else {
+		fields[name] = raw
+	}
+	return nil
+}
+
+// mergeRawField sets the fields of value on the JSON object called name, keeping the fields that value doesn't have.  A
+// nil value removes the object.
+func mergeRawField(fields map[string]json.RawMessage, name string, value any) error {
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if string(raw) == "null" {
+		delete(fields, name)
+		return nil
+	}
+
+	merged := map[string]json.RawMessage{}
+	if err := unmarshalRawField(fields, name, &merged); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, &merged); err != nil {
+		return err
+	}
+	return setRawField(fields, name, merged)
+}
+
+// DTOs
+
+type UaaProviderPolicies struct {
+	PasswordPolicy *PasswordPolicy
+	LockoutPolicy  *LockoutPolicy
+}
+
+type PasswordPolicy struct {
+	MinLength                 int `json:"minLength"`
+	MaxLength                 int `json:"maxLength"`
+	RequireUpperCaseCharacter int `json:"requireUpperCaseCharacter"`
+	RequireLowerCaseCharacter int `json:"requireLowerCaseCharacter"`
+	RequireDigit              int `json:"requireDigit"`
+	RequireSpecialCharacter   int `json:"requireSpecialCharacter"`
+	ExpirePasswordInMonths    int `json:"expirePasswordInMonths"`
+}
+
+type LockoutPolicy struct {
+	LockoutPeriodSeconds int `json:"lockoutPeriodSeconds"`
+	LockoutAfterFailures int `json:"lockoutAfterFailures"`
+	CountFailuresWithin  int `json:"countFailuresWithin"`
+}
+
+type IdentityProvider struct {
+	Id             string                  `json:"id,omitempty"`
+	IsActive       bool                    `json:"active"`
+	Name           string                  `json:"name"`
+	OriginKey      string                  `json:"originKey"`
+	Type           string                  `json:"type"`
+	IdentityZoneId string                  `json:"identityZoneId,omitempty"`
+	Version        int                     `json:"version"`
+	Config         *IdentityProviderConfig `json:"config,omitempty"`
+}
+
+// IdentityProviderConfig holds the union of the definitions UAA uses for its provider types.  Fields that only apply
+// to some types are pointers or omitted when empty so that they aren't sent for the other types.
+type IdentityProviderConfig struct {
+	AddShadowUserOnLogin    bool                   `json:"addShadowUserOnLogin"`
+	AttributeMappings       map[string]interface{} `json:"attributeMappings,omitempty"`
+	EmailDomain             []string               `json:"emailDomain,omitempty"`
+	ExternalGroupsWhitelist []string               `json:"externalGroupsWhitelist,omitempty"`
+	ProviderDescription     string                 `json:"providerDescription,omitempty"`
+	StoreCustomAttributes   bool                   `json:"storeCustomAttributes"`
+
+	// Shared by OAuth 2.0 / OIDC 1.0 and SAML
+	LinkText          string `json:"linkText,omitempty"`
+	SkipSslValidation *bool  `json:"skipSslValidation,omitempty"`
+
+	// OAuth 2.0 / OIDC 1.0
+	AuthUrl              string   `json:"authUrl,omitempty"`
+	ClientAuthInBody     *bool    `json:"clientAuthInBody,omitempty"`
+	DiscoveryUrl         string   `json:"discoveryUrl,omitempty"`
+	Issuer               string   `json:"issuer,omitempty"`
+	LogoutUrl            string   `json:"logoutUrl,omitempty"`
+	PasswordGrantEnabled *bool    `json:"passwordGrantEnabled,omitempty"`
+	Pkce                 *bool    `json:"pkce,omitempty"`
+	RelyingPartyId       string   `json:"relyingPartyId,omitempty"`
+	RelyingPartySecret   string   `json:"relyingPartySecret,omitempty"`
+	ResponseType         string   `json:"responseType,omitempty"`
+	Scopes               []string `json:"scopes,omitempty"`
+	ShowLinkText         *bool    `json:"showLinkText,omitempty"`
+	TokenKey             string   `json:"tokenKey,omitempty"`
+	TokenKeyUrl          string   `json:"tokenKeyUrl,omitempty"`
+	TokenUrl             string   `json:"tokenUrl,omitempty"`
+	UserInfoUrl          string   `json:"userInfoUrl,omitempty"`
+
+	// SAML
+	AssertionConsumerIndex *int64   `json:"assertionConsumerIndex,omitempty"`
+	AuthnContext           []string `json:"authnContext,omitempty"`
+	GroupMappingMode       string   `json:"groupMappingMode,omitempty"`
+	IconUrl                string   `json:"iconUrl,omitempty"`
+	MetadataLocation       string   `json:"metaDataLocation,omitempty"`
+	MetadataTrustCheck     *bool    `json:"metadataTrustCheck,omitempty"`
+	NameId                 string   `json:"nameID,omitempty"`
+	ShowSamlLink           *bool    `json:"showSamlLink,omitempty"`
+
+	// LDAP
+	AutoAddGroups               *bool  `json:"autoAddGroups,omitempty"`
+	BaseUrl                     string `json:"baseUrl,omitempty"`
+	BindPassword                string `json:"bindPassword,omitempty"`
+	BindUserDn                  string `json:"bindUserDn,omitempty"`
+	GroupRoleAttribute          string `json:"groupRoleAttribute,omitempty"`
+	GroupSearchBase             string `json:"groupSearchBase,omitempty"`
+	GroupSearchFilter           string `json:"groupSearchFilter,omitempty"`
+	GroupSearchSubTree          *bool  `json:"groupSearchSubTree,omitempty"`
+	LdapGroupFile               string `json:"ldapGroupFile,omitempty"`
+	LdapProfileFile             string `json:"ldapProfileFile,omitempty"`
+	MailAttributeName           string `json:"mailAttributeName,omitempty"`
+	MailSubstitute              string `json:"mailSubstitute,omitempty"`
+	MailSubstituteOverridesLdap *bool  `json:"mailSubstituteOverridesLdap,omitempty"`
+	MaxGroupSearchDepth         *int64 `json:"maxGroupSearchDepth,omitempty"`
+	Referral                    string `json:"referral,omitempty"`
+	SkipSslVerification         *bool  `json:"skipSSLVerification,omitempty"`
+	TlsConfiguration            string `json:"tlsConfiguration,omitempty"`
+	UserDnPattern               string `json:"userDNPattern,omitempty"`
+	UserSearchBase              string `json:"userSearchBase,omitempty"`
+	UserSearchFilter            string `json:"userSearchFilter,omitempty"`
+}
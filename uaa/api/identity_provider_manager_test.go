@@ -0,0 +1,46 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIdentityProviderManager_Update(t *testing.T) {
+
+	var sent IdentityProvider
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"id": "provider-id", "originKey": "my-idp", "type": "oidc1.0", "version": 7}`))
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Fatal(err)
+			}
+			updated := sent
+			updated.Version++
+			if err := json.NewEncoder(w).Encode(updated); err != nil {
+				t.Fatal(err)
+			}
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	manager := &IdentityProviderManager{log: NewLogger(false, ""), api: newTestApi(t, server, 0)}
+	updated, err := manager.Update("provider-id", &IdentityProvider{OriginKey: "my-idp", Type: "oidc1.0"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sent.Version != 7 {
+		t.Errorf("expected the current version 7 to be sent, got %d", sent.Version)
+	}
+	if updated.Version != 8 {
+		t.Errorf("expected the updated provider to be returned, got version %d", updated.Version)
+	}
+}
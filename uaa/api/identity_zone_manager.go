@@ -4,8 +4,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/net"
+	"encoding/json"
 	"fmt"
-	"net/url"
 )
 
 type IdentityZoneManager struct {
@@ -13,9 +13,9 @@ type IdentityZoneManager struct {
 	api *UaaApi
 }
 
-func newIdentityZoneManager(config coreconfig.Reader, gateway net.Gateway, logger *Logger) (izm *IdentityZoneManager, err error) {
+func newIdentityZoneManager(config coreconfig.Reader, gateway net.Gateway, options *apiOptions, logger *Logger) (izm *IdentityZoneManager, err error) {
 
-	api, err := newUaaApi(config, gateway)
+	api, err := newUaaApi(config, gateway, options)
 	if err != nil {
 		return
 	}
@@ -50,13 +50,11 @@ func (manager *IdentityZoneManager) FindById(id string) (*IdentityZone, error) {
 	return identityZone, nil
 }
 
+// FindByName looks the zone up amongst all zones, as `/identity-zones` doesn't support filters.
 func (manager *IdentityZoneManager) FindByName(name string) (*IdentityZone, error) {
 
-	displayNameFilter := url.QueryEscape(fmt.Sprintf(`name Eq "%s"`, name))
-	path := fmt.Sprintf("/identity-zones?filter=%s", displayNameFilter)
-
 	identityZones := &[]IdentityZone{}
-	err := manager.api.Get(path, identityZones)
+	err := manager.api.Get("/identity-zones", identityZones)
 	if err != nil {
 		return nil, err
 	}
@@ -70,10 +68,33 @@ func (manager *IdentityZoneManager) FindByName(name string) (*IdentityZone, erro
 	return nil, errors.NewModelNotFoundError("Identity Zone", name)
 }
 
+// List returns every identity zone, as UAA doesn't support filtering, sorting or paging them.
+func (manager *IdentityZoneManager) List() ([]IdentityZone, error) {
+
+	identityZones := []IdentityZone{}
+	if err := manager.api.Get("/identity-zones", &identityZones); err != nil {
+		return nil, err
+	}
+
+	return identityZones, nil
+}
+
+// Update merges the zone into the one UAA currently has, so that config the provider doesn't model is kept.
 func (manager *IdentityZoneManager) Update(id string, identityZone *IdentityZone) (*IdentityZone, error) {
 
 	path := fmt.Sprintf("/identity-zones/%s", id)
-	if err := manager.api.Put(path, identityZone, &identityZone); err != nil {
+
+	current := json.RawMessage{}
+	if err := manager.api.Get(path, &current); err != nil {
+		return nil, err
+	}
+
+	merged, err := mergeManagedFields(current, identityZone)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manager.api.Put(path, merged, &identityZone); err != nil {
 		return nil, err
 	}
 
@@ -96,27 +117,29 @@ type IdentityZone struct {
 }
 
 type IdentityZoneConfig struct {
-	AccountChooserEnabled bool                            `json:"accountChooserEnabled"`
-	Branding              *IdentityZoneBrandingConfig     `json:"branding,omitempty"`
-	ClientSecretPolicy    *IdentityZoneClientSecretPolicy `json:"clientSecretPolicy,omitempty"`
-	CorsPolicy            *IdentityZoneCorsPolicy         `json:"corsPolicy,omitempty"`
-	IdpDiscoveryEnabled   bool                            `json:"idpDiscoveryEnabled"`
-	InputPrompts          []*InputPrompt                  `json:"prompts,omitempty"`
-	IssuerUrl             string                          `json:"issuer,omitempty"`
-	Links                 *IdentityZoneLinks              `json:"links,omitempty"`
-	MfaConfig             *MfaConfig                      `json:"MfaConfig,omitempty"`
-	TokenPolicy           *IdentityZoneTokenPolicy        `json:"tokenPolicy,omitempty"`
-	Saml                  *IdentityZoneSamlConfig         `json:"samlConfig,omitempty"`
-	UserConfig            *UserConfig                     `json:"userConfig,omitempty"`
+	AccountChooserEnabled   bool                            `json:"accountChooserEnabled"`
+	Branding                *IdentityZoneBrandingConfig     `json:"branding,omitempty"`
+	ClientSecretPolicy      *IdentityZoneClientSecretPolicy `json:"clientSecretPolicy,omitempty"`
+	CorsPolicy              *IdentityZoneCorsPolicy         `json:"corsPolicy,omitempty"`
+	DefaultIdentityProvider string                          `json:"defaultIdentityProvider,omitempty"`
+	IdpDiscoveryEnabled     bool                            `json:"idpDiscoveryEnabled"`
+	InputPrompts            []*InputPrompt                  `json:"prompts,omitempty"`
+	IssuerUrl               string                          `json:"issuer,omitempty"`
+	Links                   *IdentityZoneLinks              `json:"links,omitempty" merge:"keep"`
+	MfaConfig               *MfaConfig                      `json:"mfaConfig,omitempty"`
+	TokenPolicy             *IdentityZoneTokenPolicy        `json:"tokenPolicy,omitempty"`
+	Saml                    *IdentityZoneSamlConfig         `json:"samlConfig,omitempty"`
+	UserConfig              *UserConfig                     `json:"userConfig,omitempty"`
 }
 
 type IdentityZoneBrandingConfig struct {
-	Banner      *IdentityZoneBrandingBanner `json:"banner,omitempty"`
-	CompanyName string                      `json:"companyName,omitempty"`
-	CompanyLogo string                      `json:"productLogo,omitempty"`
-	Favicon     string                      `json:"squareLogo,omitempty"`
-	FooterText  string                      `json:"footerLegalText,omitempty"`
-	FooterLinks map[string]string           `json:"footerLinks,omitempty"`
+	Banner      *IdentityZoneBrandingBanner  `json:"banner,omitempty"`
+	CompanyName string                       `json:"companyName,omitempty"`
+	CompanyLogo string                       `json:"productLogo,omitempty"`
+	Consent     *IdentityZoneBrandingConsent `json:"consent,omitempty"`
+	Favicon     string                       `json:"squareLogo,omitempty"`
+	FooterText  string                       `json:"footerLegalText,omitempty"`
+	FooterLinks map[string]string            `json:"footerLinks,omitempty"`
 }
 
 type IdentityZoneBrandingBanner struct {
@@ -127,6 +150,11 @@ type IdentityZoneBrandingBanner struct {
 	Url             string `json:"link,omitempty"`
 }
 
+type IdentityZoneBrandingConsent struct {
+	Text string `json:"text,omitempty"`
+	Link string `json:"link,omitempty"`
+}
+
 type IdentityZoneClientSecretPolicy struct {
 	MaxLength             *int64 `json:"maxLength,omitempty"`
 	MinLength             *int64 `json:"minLength,omitempty"`
@@ -152,13 +180,38 @@ type IdentityZoneCorsConfig struct {
 	MaxAge                *int64   `json:"maxAge,omitempty"`
 }
 
+// IdentityZoneTokenPolicy holds the zone's token settings.  UAA keeps the zone's current keys if the keys are left out
+// of an update, so a nil Keys leaves them as they are while an empty Keys removes them all.
 type IdentityZoneTokenPolicy struct {
-	AccessTokenTtl       *int64 `json:"accessTokenValidity,omitempty"`
-	RefreshTokenTtl      *int64 `json:"refreshTokenValidity,omitempty"`
-	IsJwtRevocable       bool   `json:"jwtRevocable"`
-	IsRefreshTokenUnique bool   `json:"refreshTokenUnique"`
-	RefreshTokenFormat   string `json:"refreshTokenFormat,omitempty"`
-	ActiveKeyId          string `json:"activeKeyId,omitempty"`
+	AccessTokenTtl       *int64                           `json:"accessTokenValidity,omitempty"`
+	RefreshTokenTtl      *int64                           `json:"refreshTokenValidity,omitempty"`
+	IsJwtRevocable       bool                             `json:"jwtRevocable"`
+	IsRefreshTokenUnique bool                             `json:"refreshTokenUnique"`
+	RefreshTokenFormat   string                           `json:"refreshTokenFormat,omitempty"`
+	ActiveKeyId          string                           `json:"activeKeyId,omitempty"`
+	Keys                 map[string]*IdentityZoneTokenKey `json:"keys,omitempty"`
+}
+
+type identityZoneTokenPolicy IdentityZoneTokenPolicy
+
+func (p IdentityZoneTokenPolicy) MarshalJSON() ([]byte, error) {
+
+	if p.Keys == nil || len(p.Keys) > 0 {
+		return json.Marshal(identityZoneTokenPolicy(p))
+	}
+
+	// omitempty would drop the empty keys as well
+	return json.Marshal(struct {
+		identityZoneTokenPolicy
+		Keys map[string]*IdentityZoneTokenKey `json:"keys"`
+	}{identityZoneTokenPolicy(p), p.Keys})
+}
+
+// IdentityZoneTokenKey is a token signing key.  UAA never returns the key material when reading a zone.
+type IdentityZoneTokenKey struct {
+	SigningAlg  string `json:"signingAlg,omitempty"`
+	SigningCert string `json:"signingCert,omitempty"`
+	SigningKey  string `json:"signingKey,omitempty"`
 }
 
 type IdentityZoneSamlConfig struct {
@@ -185,9 +238,10 @@ type IdentityZoneLinks struct {
 }
 
 type IdentityZoneLogoutLinks struct {
-	RedirectUrl           string   `json:"redirectUrl,omitempty"`
-	RedirectParameterName string   `json:"redirectParameterName,omitempty"`
-	AllowedRedirectUrls   []string `json:"whitelist"`
+	RedirectUrl              string   `json:"redirectUrl,omitempty"`
+	RedirectParameterName    string   `json:"redirectParameterName,omitempty"`
+	AllowedRedirectUrls      []string `json:"whitelist"`
+	DisableRedirectParameter bool     `json:"disableRedirectParameter"`
 }
 
 type SelfServiceLinks struct {
@@ -203,10 +257,16 @@ type InputPrompt struct {
 }
 
 type UserConfig struct {
-	DefaultGroups []string `json:"defaultGroups,omitempty"`
+	AllowedGroups        []string `json:"allowedGroups,omitempty"`
+	CheckCustomerEnabled bool     `json:"checkCustomerEnabled"`
+	CheckOriginEnabled   bool     `json:"checkOriginEnabled"`
+	DefaultGroups        []string `json:"defaultGroups,omitempty"`
+	MaxUsers             *int64   `json:"maxUsers,omitempty"`
 }
 
+// MfaConfig enables MFA for the zone.  The provider is referenced by the name of one of the zone's MFA providers.
 type MfaConfig struct {
 	IsEnabled         bool     `json:"enabled"`
+	ProviderName      string   `json:"providerName,omitempty"`
 	IdentityProviders []string `json:"identityProviders,omitempty"`
 }
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestIdentityZoneConfig_mfaConfig(t *testing.T) {
+
+	// As UAA returns it
+	raw := []byte(`{"mfaConfig": {"enabled": true, "providerName": "authenticator", "identityProviders": ["uaa"]}}`)
+
+	var config IdentityZoneConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		t.Fatal(err)
+	}
+	expected := &MfaConfig{IsEnabled: true, ProviderName: "authenticator", IdentityProviders: []string{"uaa"}}
+	if !reflect.DeepEqual(config.MfaConfig, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, config.MfaConfig)
+	}
+
+	marshalled, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(marshalled, &fields); err != nil {
+		t.Fatal(err)
+	}
+	var sent MfaConfig
+	if err := json.Unmarshal(fields["mfaConfig"], &sent); err != nil {
+		t.Fatalf("expected the MFA config to be sent as mfaConfig, got %s", marshalled)
+	}
+	if !reflect.DeepEqual(&sent, expected) {
+		t.Errorf("expected %+v to be sent, got %+v", expected, sent)
+	}
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/net"
+	"fmt"
+)
+
+const mfaProvidersPath = "/mfa-providers"
+
+type MfaProviderManager struct {
+	log *Logger
+	api *UaaApi
+}
+
+func newMfaProviderManager(config coreconfig.Reader, gateway net.Gateway, options *apiOptions, logger *Logger) (mpm *MfaProviderManager, err error) {
+
+	api, err := newUaaApi(config, gateway, options)
+	if err != nil {
+		return
+	}
+
+	mpm = &MfaProviderManager{
+		log: logger,
+		api: api,
+	}
+	return
+}
+
+// CRUD methods; UAA doesn't allow MFA providers to be changed once they're created
+
+func (manager *MfaProviderManager) Create(mfaProvider *MfaProvider, zoneId string) (*MfaProvider, error) {
+
+	if err := manager.api.WithZoneId(zoneId).Post(mfaProvidersPath, mfaProvider, &mfaProvider); err != nil {
+		return nil, err
+	}
+
+	return mfaProvider, nil
+}
+
+func (manager *MfaProviderManager) FindById(id, zoneId string) (*MfaProvider, error) {
+
+	path := fmt.Sprintf("%s/%s", mfaProvidersPath, id)
+	mfaProvider := &MfaProvider{}
+	if err := manager.api.WithZoneId(zoneId).Get(path, mfaProvider); err != nil {
+		return nil, err
+	}
+
+	return mfaProvider, nil
+}
+
+func (manager *MfaProviderManager) FindByName(name, zoneId string) (*MfaProvider, error) {
+
+	mfaProviders := &[]MfaProvider{}
+	if err := manager.api.WithZoneId(zoneId).Get(mfaProvidersPath, mfaProviders); err != nil {
+		return nil, err
+	}
+
+	for _, mfaProvider := range *mfaProviders {
+		if mfaProvider.Name == name {
+			return &mfaProvider, nil
+		}
+	}
+
+	return nil, errors.NewModelNotFoundError("MFA Provider", name)
+}
+
+func (manager *MfaProviderManager) Delete(id, zoneId string) error {
+
+	return manager.api.WithZoneId(zoneId).Delete(fmt.Sprintf("%s/%s", mfaProvidersPath, id))
+}
+
+// DTOs
+
+type MfaProvider struct {
+	Id             string             `json:"id,omitempty"`
+	Name           string             `json:"name"`
+	Type           string             `json:"type"`
+	IdentityZoneId string             `json:"identityZoneId,omitempty"`
+	Config         *MfaProviderConfig `json:"config,omitempty"`
+}
+
+type MfaProviderConfig struct {
+	Algorithm string `json:"algorithm,omitempty"`
+	Digits    int    `json:"digits,omitempty"`
+	Duration  int    `json:"duration,omitempty"`
+	Issuer    string `json:"issuer,omitempty"`
+}
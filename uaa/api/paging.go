@@ -0,0 +1,128 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// UAA caps the `count` of most list endpoints at 500 so larger page sizes are silently truncated.
+const DefaultPageSize = 100
+const MaxPageSize = 500
+
+const SortOrderAscending = "ascending"
+const SortOrderDescending = "descending"
+
+// ListOptions narrow down and order the resources returned by a SCIM list endpoint.  Filter is a SCIM filter
+// expression, e.g. `origin eq "uaa" and active eq true`, and Attributes limits the attributes that are returned.
+type ListOptions struct {
+	Filter     string
+	SortBy     string
+	SortOrder  string
+	Attributes []string
+}
+
+func (o ListOptions) query() url.Values {
+
+	query := url.Values{}
+	if o.Filter != "" {
+		query.Set("filter", o.Filter)
+	}
+	if o.SortBy != "" {
+		query.Set("sortBy", o.SortBy)
+	}
+	if o.SortOrder != "" {
+		query.Set("sortOrder", o.SortOrder)
+	}
+	if len(o.Attributes) > 0 {
+		query.Set("attributes", strings.Join(o.Attributes, ","))
+	}
+	return query
+}
+
+// scimPage is a single page of results returned by a SCIM list endpoint such as `/Users`, `/Groups` or
+// `/oauth/clients`.
+type scimPage[T any] struct {
+	Resources    []T `json:"resources"`
+	StartIndex   int `json:"startIndex"`
+	ItemsPerPage int `json:"itemsPerPage"`
+	TotalResults int `json:"totalResults"`
+}
+
+// pageIterator walks through a SCIM list endpoint one page at a time using the `startIndex` and `count` query
+// parameters, stopping once `totalResults` have been read.
+type pageIterator[T any] struct {
+	api        *UaaApi
+	path       string
+	query      url.Values
+	startIndex int
+	total      int
+	started    bool
+}
+
+func newPageIterator[T any](api *UaaApi, path string, query url.Values) *pageIterator[T] {
+
+	if query == nil {
+		query = url.Values{}
+	}
+
+	return &pageIterator[T]{
+		api:        api,
+		path:       path,
+		query:      query,
+		startIndex: 1,
+	}
+}
+
+func (it *pageIterator[T]) HasNext() bool {
+	return !it.started || it.startIndex <= it.total
+}
+
+func (it *pageIterator[T]) Next() ([]T, error) {
+
+	query := url.Values{}
+	for k, v := range it.query {
+		query[k] = v
+	}
+	query.Set("startIndex", strconv.Itoa(it.startIndex))
+	query.Set("count", strconv.Itoa(it.api.pageSize()))
+
+	page := &scimPage[T]{}
+	if err := it.api.Get(fmt.Sprintf("%s?%s", it.path, query.Encode()), page); err != nil {
+		return nil, err
+	}
+
+	it.started = true
+	it.total = page.TotalResults
+	if len(page.Resources) == 0 {
+		// Guard against servers that report more results than they return
+		it.startIndex = it.total + 1
+	} else {
+		it.startIndex += len(page.Resources)
+	}
+
+	return page.Resources, nil
+}
+
+// listAll reads every page of a SCIM list endpoint and returns the combined resources.
+func listAll[T any](api *UaaApi, path string, query url.Values) ([]T, error) {
+
+	resources := []T{}
+
+	it := newPageIterator[T](api, path, query)
+	for it.HasNext() {
+		page, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, page...)
+	}
+
+	return resources, nil
+}
+
+// filterQuery creates the query parameters for a SCIM `filter` expression.
+func filterQuery(format string, a ...any) url.Values {
+	return url.Values{"filter": {fmt.Sprintf(format, a...)}}
+}
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/net"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type pagedItem struct {
+	Id int `json:"id"`
+}
+
+// newPagingServer serves a SCIM list endpoint with total items, of which it returns at most served per page no matter
+// the count that is requested.
+func newPagingServer(t *testing.T, total, served int, requests *[]string) *httptest.Server {
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		*requests = append(*requests, r.URL.RawQuery)
+
+		startIndex, _ := strconv.Atoi(r.URL.Query().Get("startIndex"))
+		count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+		if count > served {
+			count = served
+		}
+
+		page := scimPage[pagedItem]{StartIndex: startIndex, TotalResults: total}
+		for i := startIndex; i < startIndex+count && i <= total; i++ {
+			page.Resources = append(page.Resources, pagedItem{Id: i})
+		}
+		page.ItemsPerPage = len(page.Resources)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Fatal(err)
+		}
+	}))
+}
+
+func newTestApi(t *testing.T, server *httptest.Server, pageSize int) *UaaApi {
+
+	log := NewLogger(false, "")
+	config := coreconfig.NewRepositoryFromPersistor(newNoopPersistor(), func(err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	if i18n.T == nil {
+		i18n.T = i18n.Init(config)
+	}
+	config.SetUaaEndpoint(server.URL)
+
+	api, err := newUaaApi(config, net.NewUAAGateway(config, log.UI, log.TracePrinter, ""), &apiOptions{pageSize: pageSize})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+func TestListAll(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		total    int
+		served   int
+		pageSize int
+		requests []string
+	}{
+		{
+			name:     "empty",
+			total:    0,
+			served:   500,
+			pageSize: 2,
+			requests: []string{"count=2&startIndex=1"},
+		},
+		{
+			name:     "single page",
+			total:    2,
+			served:   500,
+			pageSize: 2,
+			requests: []string{"count=2&startIndex=1"},
+		},
+		{
+			name:     "multiple pages",
+			total:    5,
+			served:   500,
+			pageSize: 2,
+			requests: []string{"count=2&startIndex=1", "count=2&startIndex=3", "count=2&startIndex=5"},
+		},
+		{
+			name:     "pages truncated by the server",
+			total:    5,
+			served:   3,
+			pageSize: 4,
+			requests: []string{"count=4&startIndex=1", "count=4&startIndex=4"},
+		},
+		{
+			name:     "default page size",
+			total:    1,
+			served:   500,
+			pageSize: 0,
+			requests: []string{"count=100&startIndex=1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			requests := []string{}
+			server := newPagingServer(t, test.total, test.served, &requests)
+			defer server.Close()
+
+			items, err := listAll[pagedItem](newTestApi(t, server, test.pageSize), "/Things", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(items) != test.total {
+				t.Errorf("expected %d items, got %d", test.total, len(items))
+			}
+			for i, item := range items {
+				if item.Id != i+1 {
+					t.Errorf("expected item %d to have ID %d, got %d", i, i+1, item.Id)
+				}
+			}
+			if !reflect.DeepEqual(requests, test.requests) {
+				t.Errorf("expected requests %v, got %v", test.requests, requests)
+			}
+		})
+	}
+}
+
+// A server that reports more results than it returns mustn't be paged through forever.
+func TestListAll_totalResultsOverstated(t *testing.T) {
+
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"resources": [], "startIndex": 1, "itemsPerPage": 0, "totalResults": 10}`))
+	}))
+	defer server.Close()
+
+	items, err := listAll[pagedItem](newTestApi(t, server, 2), "/Things", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 0 {
+		t.Errorf("expected no items, got %d", len(items))
+	}
+	if len(requests) != 1 {
+		t.Errorf("expected a single request, got %v", requests)
+	}
+}
+
+func TestListAll_keepsQuery(t *testing.T) {
+
+	requests := []string{}
+	server := newPagingServer(t, 3, 500, &requests)
+	defer server.Close()
+
+	options := ListOptions{Filter: `origin eq "uaa"`, SortBy: "userName", SortOrder: SortOrderDescending}
+	if _, err := listAll[pagedItem](newTestApi(t, server, 2), "/Things", options.query()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"count=2&filter=origin+eq+%22uaa%22&sortBy=userName&sortOrder=descending&startIndex=1",
+		"count=2&filter=origin+eq+%22uaa%22&sortBy=userName&sortOrder=descending&startIndex=3",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestListOptions_query(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		options  ListOptions
+		expected string
+	}{
+		{
+			name:     "empty",
+			options:  ListOptions{},
+			expected: "",
+		},
+		{
+			name:     "filter",
+			options:  ListOptions{Filter: `active eq true`},
+			expected: "filter=active+eq+true",
+		},
+		{
+			name:     "all",
+			options:  ListOptions{Filter: `id pr`, SortBy: "id", SortOrder: SortOrderAscending, Attributes: []string{"id", "userName"}},
+			expected: "attributes=id%2CuserName&filter=id+pr&sortBy=id&sortOrder=ascending",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := test.options.query().Encode(); actual != test.expected {
+				t.Errorf("expected query '%s', got '%s'", test.expected, actual)
+			}
+		})
+	}
+}
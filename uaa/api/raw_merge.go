@@ -0,0 +1,80 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// UAA replaces the whole resource on a PUT, so anything the provider doesn't model would be lost on every update.
+// Zones, clients and users are therefore read first and the managed fields merged into the document UAA returned, so
+// fields added by newer UAA releases, or set by other tools, are sent back untouched.
+
+// mergeManagedFields returns the current JSON document with the fields of managed merged into it.  Every field that
+// managed's type declares is replaced by its value, or removed when managed omits it, unless it's tagged
+// `merge:"keep"`, while fields it doesn't declare are kept.  Nested objects are merged the same way; lists and maps
+// are replaced as a whole.
+func mergeManagedFields(current json.RawMessage, managed any) (json.RawMessage, error) {
+
+	raw, err := json.Marshal(managed)
+	if err != nil {
+		return nil, err
+	}
+	return mergeRawObject(current, raw, reflect.TypeOf(managed))
+}
+
+func mergeRawObject(current, managed json.RawMessage, managedType reflect.Type) (json.RawMessage, error) {
+
+	for managedType != nil && managedType.Kind() == reflect.Pointer {
+		managedType = managedType.Elem()
+	}
+	if managedType == nil || managedType.Kind() != reflect.Struct {
+		return managed, nil
+	}
+
+	var currentFields, managedFields map[string]json.RawMessage
+	if json.Unmarshal(current, &currentFields) != nil || currentFields == nil {
+		return managed, nil
+	}
+	if json.Unmarshal(managed, &managedFields) != nil || managedFields == nil {
+		return managed, nil
+	}
+
+	for i := 0; i < managedType.NumField(); i++ {
+		field := managedType.Field(i)
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		value, isManaged := managedFields[name]
+		if !isManaged {
+			if field.Tag.Get("merge") != "keep" {
+				delete(currentFields, name)
+			}
+			continue
+		}
+		if existing, ok := currentFields[name]; ok {
+			var err error
+			if value, err = mergeRawObject(existing, value, field.Type); err != nil {
+				return nil, err
+			}
+		}
+		currentFields[name] = value
+	}
+
+	return json.Marshal(currentFields)
+}
+
+// jsonFieldName returns the name a struct field is serialized as, and false if it isn't serialized at all.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+
+	tag := field.Tag.Get("json")
+	if !field.IsExported() || tag == "-" {
+		return "", false
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return field.Name, true
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type mergedNested struct {
+	Enabled bool   `json:"enabled"`
+	Name    string `json:"name,omitempty"`
+}
+
+type mergedResource struct {
+	Id       string            `json:"id"`
+	Name     string            `json:"name,omitempty"`
+	Nested   *mergedNested     `json:"nested,omitempty"`
+	Kept     *mergedNested     `json:"kept,omitempty" merge:"keep"`
+	List     []string          `json:"list,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Ignored  string            `json:"-"`
+	internal string
+}
+
+func TestMergeManagedFields(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		current  string
+		managed  mergedResource
+		expected string
+	}{
+		{
+			name:     "unmanaged fields are kept",
+			current:  `{"id": "1", "name": "old", "created": 1234, "meta": {"version": 2}}`,
+			managed:  mergedResource{Id: "1", Name: "new"},
+			expected: `{"id": "1", "name": "new", "created": 1234, "meta": {"version": 2}}`,
+		},
+		{
+			name:     "omitted managed fields are removed",
+			current:  `{"id": "1", "name": "old", "list": ["a"], "labels": {"a": "b"}, "other": true}`,
+			managed:  mergedResource{Id: "1"},
+			expected: `{"id": "1", "other": true}`,
+		},
+		{
+			name:     "managed fields missing from the current document are added",
+			current:  `{"id": "1"}`,
+			managed:  mergedResource{Id: "1", Name: "new", Nested: &mergedNested{Enabled: true}},
+			expected: `{"id": "1", "name": "new", "nested": {"enabled": true}}`,
+		},
+		{
+			name:     "nested objects are merged",
+			current:  `{"id": "1", "nested": {"enabled": false, "name": "old", "extra": [1, 2]}}`,
+			managed:  mergedResource{Id: "1", Nested: &mergedNested{Enabled: true}},
+			expected: `{"id": "1", "nested": {"enabled": true, "extra": [1, 2]}}`,
+		},
+		{
+			name:     "omitted nested objects are removed",
+			current:  `{"id": "1", "nested": {"enabled": true, "extra": 1}}`,
+			managed:  mergedResource{Id: "1"},
+			expected: `{"id": "1"}`,
+		},
+		{
+			name:     "omitted fields tagged to be kept are kept",
+			current:  `{"id": "1", "kept": {"enabled": true, "extra": 1}}`,
+			managed:  mergedResource{Id: "1"},
+			expected: `{"id": "1", "kept": {"enabled": true, "extra": 1}}`,
+		},
+		{
+			name:     "fields tagged to be kept are merged when set",
+			current:  `{"id": "1", "kept": {"enabled": true, "name": "old", "extra": 1}}`,
+			managed:  mergedResource{Id: "1", Kept: &mergedNested{Enabled: false}},
+			expected: `{"id": "1", "kept": {"enabled": false, "extra": 1}}`,
+		},
+		{
+			name:     "lists are replaced",
+			current:  `{"id": "1", "list": ["a", "b"]}`,
+			managed:  mergedResource{Id: "1", List: []string{"c"}},
+			expected: `{"id": "1", "list": ["c"]}`,
+		},
+		{
+			name:     "maps are replaced",
+			current:  `{"id": "1", "labels": {"a": "1", "b": "2"}}`,
+			managed:  mergedResource{Id: "1", Labels: map[string]string{"c": "3"}},
+			expected: `{"id": "1", "labels": {"c": "3"}}`,
+		},
+		{
+			name:     "fields that aren't serialized are left alone",
+			current:  `{"id": "1", "Ignored": "x", "internal": "y"}`,
+			managed:  mergedResource{Id: "1", Ignored: "a", internal: "b"},
+			expected: `{"id": "1", "Ignored": "x", "internal": "y"}`,
+		},
+		{
+			name:     "a current value that isn't an object is replaced",
+			current:  `{"id": "1", "nested": "unexpected"}`,
+			managed:  mergedResource{Id: "1", Nested: &mergedNested{Name: "new"}},
+			expected: `{"id": "1", "nested": {"enabled": false, "name": "new"}}`,
+		},
+		{
+			name:     "an empty current document is replaced",
+			current:  `null`,
+			managed:  mergedResource{Id: "1", Name: "new"},
+			expected: `{"id": "1", "name": "new"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			merged, err := mergeManagedFields(json.RawMessage(test.current), &test.managed)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var actual, expected any
+			if err := json.Unmarshal(merged, &actual); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(test.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("expected %s, got %s", test.expected, merged)
+			}
+		})
+	}
+}
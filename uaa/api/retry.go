@@ -0,0 +1,172 @@
+package api
+
+import (
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/net"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DefaultRetryMaxAttempts = 3
+const DefaultRetryBaseBackoff = time.Second
+const DefaultRetryMaxBackoff = 30 * time.Second
+
+// DefaultRetryStatusCodes are the responses UAA and the routers in front of it give while it's overloaded or being
+// redeployed.
+var DefaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy decides which failed requests are retried and how long to wait in between.  Requests that fail with one
+// of the StatusCodes are retried with an exponential backoff starting at BaseBackoff and capped at MaxBackoff.  A
+// `Retry-After` header on the response takes precedence over the backoff.
+//
+// Requests that fail without a response at all are only retried if they are idempotent, as a POST may have been
+// processed before the connection was lost.  The gateway may already have resent such a request before giving up,
+// which counts as a single attempt so that the policy still backs off before trying again.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	StatusCodes []int
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		BaseBackoff: DefaultRetryBaseBackoff,
+		MaxBackoff:  DefaultRetryMaxBackoff,
+		StatusCodes: DefaultRetryStatusCodes,
+	}
+}
+
+// do calls attempt until it succeeds, fails in a way that isn't transient, or the attempts have been used up.  The
+// error of the last attempt is returned.
+func (p *RetryPolicy) do(log *Logger, method string, attempt func() (*http.Response, error)) error {
+
+	for n := 1; ; n++ {
+		response, err := attempt()
+		if err == nil || p == nil || n >= p.MaxAttempts || !p.isRetryable(method, response, err) {
+			return err
+		}
+
+		delay := p.delay(n, response)
+		if log != nil {
+			log.DebugMessage("Request failed, retrying in %s (%d of %d attempts made): %s", delay, n, p.MaxAttempts, err.Error())
+		}
+		time.Sleep(delay)
+	}
+}
+
+func (p *RetryPolicy) isRetryable(method string, response *http.Response, err error) bool {
+
+	if response == nil {
+		// The request never got a response, e.g. the connection was refused or reset.  Certificate errors won't go
+		// away by trying again though.
+		_, invalidCert := err.(*cferrors.InvalidSSLCert)
+		return !invalidCert && isIdempotent(method)
+	}
+
+	for _, code := range p.StatusCodes {
+		if response.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the next attempt after the given attempt failed.
+func (p *RetryPolicy) delay(attempt int, response *http.Response) time.Duration {
+
+	if response != nil {
+		if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+			return retryAfter
+		}
+	}
+
+	delay := p.BaseBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// parseRetryAfter reads a `Retry-After` header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// performJSONRequest performs the request and decodes the JSON response like the gateway's
+// PerformRequestForJSONResponse, but also returns the raw response so that its status and headers can be inspected
+// when the request fails.  The request can be performed again if it has to be retried.
+func performJSONRequest(gateway net.Gateway, request *net.Request, responseBody any) (*http.Response, error) {
+
+	// The body has been read by any previous attempt
+	if request.SeekableBody != nil {
+		if _, err := request.SeekableBody.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := gateway.PerformRequest(request)
+	if response == nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, readErr := io.ReadAll(response.Body)
+	if err != nil {
+		// Error responses sometimes carry details that callers look for in the response body
+		_ = json.Unmarshal(body, responseBody)
+		return response, err
+	}
+	if readErr != nil {
+		return response, readErr
+	}
+
+	if response.StatusCode > 203 || strings.TrimSpace(string(body)) == "" {
+		return response, nil
+	}
+
+	if err := json.Unmarshal(body, responseBody); err != nil {
+		return response, fmt.Errorf("invalid JSON response from server: %s", err.Error())
+	}
+	return response, nil
+}
@@ -0,0 +1,238 @@
+package api
+
+import (
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestResponse(statusCode int, retryAfter string) *http.Response {
+
+	response := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+	if retryAfter != "" {
+		response.Header.Set("Retry-After", retryAfter)
+	}
+	return response
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+
+	policy := &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	tests := []struct {
+		name     string
+		attempt  int
+		response *http.Response
+		expected time.Duration
+	}{
+		{
+			name:     "first retry",
+			attempt:  1,
+			expected: time.Second,
+		},
+		{
+			name:     "doubles",
+			attempt:  3,
+			expected: 4 * time.Second,
+		},
+		{
+			name:     "capped",
+			attempt:  4,
+			expected: 5 * time.Second,
+		},
+		{
+			name:     "capped without overflowing",
+			attempt:  100,
+			expected: 5 * time.Second,
+		},
+		{
+			name:     "retry after seconds",
+			attempt:  1,
+			response: newTestResponse(http.StatusTooManyRequests, "7"),
+			expected: 7 * time.Second,
+		},
+		{
+			name:     "retry after date in the past",
+			attempt:  1,
+			response: newTestResponse(http.StatusServiceUnavailable, "Wed, 21 Oct 2015 07:28:00 GMT"),
+			expected: 0,
+		},
+		{
+			name:     "invalid retry after",
+			attempt:  2,
+			response: newTestResponse(http.StatusServiceUnavailable, "soon"),
+			expected: 2 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := policy.delay(test.attempt, test.response); actual != test.expected {
+				t.Errorf("expected a delay of %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		value string
+		ok    bool
+		min   time.Duration
+		max   time.Duration
+	}{
+		{name: "empty", value: "", ok: false},
+		{name: "seconds", value: "120", ok: true, min: 2 * time.Minute, max: 2 * time.Minute},
+		{name: "padded", value: " 3 ", ok: true, min: 3 * time.Second, max: 3 * time.Second},
+		{name: "negative", value: "-1", ok: false},
+		{name: "date", value: time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), ok: true, min: 58 * time.Second, max: time.Minute},
+		{name: "garbage", value: "later", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, ok := parseRetryAfter(test.value)
+			if ok != test.ok {
+				t.Fatalf("expected ok to be %t, got %t", test.ok, ok)
+			}
+			if ok && (actual < test.min || actual > test.max) {
+				t.Errorf("expected a delay between %s and %s, got %s", test.min, test.max, actual)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_isRetryable(t *testing.T) {
+
+	policy := DefaultRetryPolicy()
+	networkErr := errors.New("connection reset by peer")
+
+	tests := []struct {
+		name     string
+		method   string
+		response *http.Response
+		err      error
+		expected bool
+	}{
+		{"retryable status", http.MethodPost, newTestResponse(http.StatusServiceUnavailable, ""), networkErr, true},
+		{"other status", http.MethodGet, newTestResponse(http.StatusBadRequest, ""), networkErr, false},
+		{"no response to GET", http.MethodGet, nil, networkErr, true},
+		{"no response to PUT", http.MethodPut, nil, networkErr, true},
+		{"no response to DELETE", http.MethodDelete, nil, networkErr, true},
+		{"no response to POST", http.MethodPost, nil, networkErr, false},
+		{"no response to PATCH", http.MethodPatch, nil, networkErr, false},
+		{"invalid certificate", http.MethodGet, nil, cferrors.NewInvalidSSLCert("uaa.example.com", "unknown authority"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := policy.isRetryable(test.method, test.response, test.err); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_do(t *testing.T) {
+
+	policy := &RetryPolicy{
+		MaxAttempts: 4,
+		BaseBackoff: time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+		StatusCodes: DefaultRetryStatusCodes,
+	}
+	failure := errors.New("failed")
+
+	tests := []struct {
+		name     string
+		policy   *RetryPolicy
+		method   string
+		response *http.Response
+		attempts int
+	}{
+		{"retryable status", policy, http.MethodGet, newTestResponse(http.StatusBadGateway, ""), 4},
+		{"other status", policy, http.MethodGet, newTestResponse(http.StatusConflict, ""), 1},
+		{"no response", policy, http.MethodGet, nil, 4},
+		{"no response to POST", policy, http.MethodPost, nil, 1},
+		{"no policy", nil, http.MethodGet, newTestResponse(http.StatusBadGateway, ""), 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			attempts := 0
+			err := test.policy.do(nil, test.method, func() (*http.Response, error) {
+				attempts++
+				return test.response, failure
+			})
+
+			if err != failure {
+				t.Errorf("expected the error of the last attempt, got %v", err)
+			}
+			if attempts != test.attempts {
+				t.Errorf("expected %d attempts, got %d", test.attempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_do_succeeds(t *testing.T) {
+
+	policy := &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: time.Millisecond, StatusCodes: DefaultRetryStatusCodes}
+
+	attempts := 0
+	err := policy.do(nil, http.MethodGet, func() (*http.Response, error) {
+		attempts++
+		if attempts < 2 {
+			return newTestResponse(http.StatusTooManyRequests, ""), errors.New("too many requests")
+		}
+		return newTestResponse(http.StatusOK, ""), nil
+	})
+
+	if err != nil {
+		t.Errorf("expected the retry to succeed, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+// A request whose connection is reset is retried with a backoff, even when the policy only allows a few attempts.
+func TestRetryPolicy_resetConnection(t *testing.T) {
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		requests++
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = 10 * time.Millisecond
+
+	api := newTestApi(t, server, 0)
+	api.options.retryPolicy = policy
+
+	start := time.Now()
+	if err := api.Get("/Things/1", &pagedItem{}); err == nil {
+		t.Fatal("expected the request to fail")
+	}
+
+	if requests < DefaultRetryMaxAttempts {
+		t.Errorf("expected at least %d requests, got %d", DefaultRetryMaxAttempts, requests)
+	}
+	// The two retries wait for the base backoff and then twice as long
+	if elapsed, backoff := time.Since(start), 3*policy.BaseBackoff; elapsed < backoff {
+		t.Errorf("expected the retries to back off for at least %s, took %s", backoff, elapsed)
+	}
+}
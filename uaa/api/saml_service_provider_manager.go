@@ -0,0 +1,138 @@
+package api
+
+import (
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/net"
+	"encoding/json"
+	"fmt"
+)
+
+const samlServiceProvidersPath = "/saml/service-providers"
+
+type SamlServiceProviderManager struct {
+	log *Logger
+	api *UaaApi
+}
+
+func newSamlServiceProviderManager(config coreconfig.Reader, gateway net.Gateway, options *apiOptions, logger *Logger) (sspm *SamlServiceProviderManager, err error) {
+
+	api, err := newUaaApi(config, gateway, options)
+	if err != nil {
+		return
+	}
+
+	sspm = &SamlServiceProviderManager{
+		log: logger,
+		api: api,
+	}
+	return
+}
+
+// CRUD methods
+
+func (manager *SamlServiceProviderManager) Create(serviceProvider *SamlServiceProvider, zoneId string) (*SamlServiceProvider, error) {
+
+	if err := manager.api.WithZoneId(zoneId).Post(samlServiceProvidersPath, serviceProvider, &serviceProvider); err != nil {
+		return nil, err
+	}
+
+	return serviceProvider, nil
+}
+
+func (manager *SamlServiceProviderManager) FindById(id, zoneId string) (*SamlServiceProvider, error) {
+
+	path := fmt.Sprintf("%s/%s", samlServiceProvidersPath, id)
+	serviceProvider := &SamlServiceProvider{}
+	if err := manager.api.WithZoneId(zoneId).Get(path, serviceProvider); err != nil {
+		return nil, err
+	}
+
+	return serviceProvider, nil
+}
+
+func (manager *SamlServiceProviderManager) FindByEntityId(entityId, zoneId string) (*SamlServiceProvider, error) {
+
+	serviceProviders := &[]SamlServiceProvider{}
+	if err := manager.api.WithZoneId(zoneId).Get(samlServiceProvidersPath, serviceProviders); err != nil {
+		return nil, err
+	}
+
+	for _, serviceProvider := range *serviceProviders {
+		if serviceProvider.EntityId == entityId {
+			return &serviceProvider, nil
+		}
+	}
+
+	return nil, errors.NewModelNotFoundError("SAML Service Provider", entityId)
+}
+
+func (manager *SamlServiceProviderManager) Update(id string, serviceProvider *SamlServiceProvider, zoneId string) (*SamlServiceProvider, error) {
+
+	path := fmt.Sprintf("%s/%s", samlServiceProvidersPath, id)
+	if err := manager.api.WithZoneId(zoneId).Put(path, serviceProvider, &serviceProvider); err != nil {
+		return nil, err
+	}
+
+	return serviceProvider, nil
+}
+
+func (manager *SamlServiceProviderManager) Delete(id, zoneId string) error {
+
+	return manager.api.WithZoneId(zoneId).Delete(fmt.Sprintf("%s/%s", samlServiceProvidersPath, id))
+}
+
+// DTOs
+
+type SamlServiceProvider struct {
+	Id             string                     `json:"id,omitempty"`
+	IsActive       bool                       `json:"active"`
+	Name           string                     `json:"name"`
+	EntityId       string                     `json:"entityId"`
+	IdentityZoneId string                     `json:"identityZoneId,omitempty"`
+	Version        int                        `json:"version"`
+	Config         *SamlServiceProviderConfig `json:"config,omitempty"`
+}
+
+type SamlServiceProviderConfig struct {
+	AttributeMappings     map[string]interface{} `json:"attributeMappings,omitempty"`
+	EnableIdpInitiatedSso bool                   `json:"enableIdpInitiatedSso"`
+	MetadataLocation      string                 `json:"metaDataLocation,omitempty"`
+	NameId                string                 `json:"nameID,omitempty"`
+}
+
+// UAA exchanges the config of a service provider as a JSON encoded string rather than as an object.
+type samlServiceProviderJson struct {
+	*samlServiceProvider
+	Config string `json:"config,omitempty"`
+}
+
+type samlServiceProvider SamlServiceProvider
+
+func (sp SamlServiceProvider) MarshalJSON() ([]byte, error) {
+
+	body := samlServiceProviderJson{samlServiceProvider: (*samlServiceProvider)(&sp)}
+	if sp.Config != nil {
+		config, err := json.Marshal(sp.Config)
+		if err != nil {
+			return nil, err
+		}
+		body.Config = string(config)
+	}
+	return json.Marshal(body)
+}
+
+func (sp *SamlServiceProvider) UnmarshalJSON(data []byte) error {
+
+	body := samlServiceProviderJson{samlServiceProvider: (*samlServiceProvider)(sp)}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	sp.Config = nil
+	if body.Config == "" {
+		return nil
+	}
+	sp.Config = &SamlServiceProviderConfig{}
+	return json.Unmarshal([]byte(body.Config), sp.Config)
+}
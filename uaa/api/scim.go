@@ -0,0 +1,17 @@
+package api
+
+// UAAMeta is the SCIM `meta` of users and groups.  Its version is incremented by every change, and is sent back in the
+// `If-Match` header of updates so that UAA rejects changes to a resource that was modified in the meantime.
+type UAAMeta struct {
+	Version      int    `json:"version"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// GetVersion returns the SCIM version, or 0 if the response had no `meta`.
+func (m *UAAMeta) GetVersion() int {
+	if m == nil {
+		return 0
+	}
+	return m.Version
+}
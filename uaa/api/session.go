@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/envvars"
 	"os"
 	"strconv"
@@ -15,14 +16,20 @@ import (
 type Session struct {
 	Log *Logger
 
-	config     coreconfig.Repository
-	uaaGateway net.Gateway
-
-	authManager        *AuthManager
-	clientManager      *ClientManager
-	groupManager       *GroupManager
-	identityZoneManger *IdentityZoneManager
-	userManager        *UserManager
+	config         coreconfig.Repository
+	tokenRefresher *tokenRefresher
+	uaaGateway     net.Gateway
+	zoneId         string
+
+	authManager                *AuthManager
+	clientManager              *ClientManager
+	groupManager               *GroupManager
+	identityProviderManager    *IdentityProviderManager
+	identityZoneManger         *IdentityZoneManager
+	mfaProviderManager         *MfaProviderManager
+	samlServiceProviderManager *SamlServiceProviderManager
+	tokenKeyManager            *TokenKeyManager
+	userManager                *UserManager
 }
 
 type Config struct {
@@ -30,10 +37,23 @@ type Config struct {
 	AuthEndpoint      string
 	ClientID          string
 	ClientSecret      string
+	Username          string
+	Password          string
+	AccessToken       string
+	JwtAssertion      string
 	CaCert            string
 	SkipSslValidation bool
+	PageSize          int
+	ForceOverwrite    bool
+	RetryPolicy       *RetryPolicy
+	ZoneSubdomain     string
 }
 
+// The client used for the password and JWT bearer grants when no client ID is configured.
+const defaultUserClientID = "cf"
+
+const defaultZoneId = "uaa"
+
 func (config *Config) Client() (*Session, error) {
 	return NewSession(config)
 }
@@ -64,37 +84,133 @@ func NewSession(config *Config) (s *Session, err error) {
 	s.config.SetSSLDisabled(config.SkipSslValidation)
 
 	s.config.SetAuthenticationEndpoint(endpointAsURL(config.LoginEndpoint))
-	s.config.SetUaaEndpoint(endpointAsURL(config.AuthEndpoint))
+	s.config.SetUaaEndpoint(endpointWithSubDomain(endpointAsURL(config.AuthEndpoint), config.ZoneSubdomain))
 
 	s.uaaGateway = net.NewUAAGateway(s.config, s.Log.UI, s.Log.TracePrinter, envDialTimeout)
+	retryPolicy := config.RetryPolicy
+	if retryPolicy == nil {
+		retryPolicy = DefaultRetryPolicy()
+	}
+
 	s.authManager = NewAuthManager(s.uaaGateway, s.config, net.NewRequestDumper(s.Log.TracePrinter))
-	//s.uaaGateway.SetTokenRefresher(s.authManager)
+	s.authManager.SetRetryPolicy(retryPolicy, s.Log)
+	s.tokenRefresher = newTokenRefresher(s.authenticator(config), s.Log)
+	s.uaaGateway.SetTokenRefresher(s.tokenRefresher)
+
+	options := &apiOptions{
+		forceOverwrite: config.ForceOverwrite,
+		log:            s.Log,
+		pageSize:       config.PageSize,
+		retryPolicy:    retryPolicy,
+		tokenRefresher: s.tokenRefresher,
+	}
+
+	s.identityZoneManger, err = newIdentityZoneManager(s.config, s.uaaGateway, options, s.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	s.identityProviderManager, err = newIdentityProviderManager(s.config, s.uaaGateway, options, s.Log)
+	if err != nil {
+		return nil, err
+	}
 
-	s.identityZoneManger, err = newIdentityZoneManager(s.config, s.uaaGateway, s.Log)
+	s.userManager, err = newUserManager(s.config, s.uaaGateway, options, s.identityZoneManger, s.Log)
 	if err != nil {
 		return nil, err
 	}
 
-	s.userManager, err = newUserManager(s.config, s.uaaGateway, s.identityZoneManger, s.Log)
+	s.groupManager, err = newGroupManager(s.config, s.uaaGateway, options, s.Log)
 	if err != nil {
 		return nil, err
 	}
 
-	s.groupManager, err = newGroupManager(s.config, s.uaaGateway, s.Log)
+	s.clientManager, err = newClientManager(s.config, s.uaaGateway, options, s.Log)
 	if err != nil {
 		return nil, err
 	}
 
-	s.clientManager, err = newClientManager(s.config, s.uaaGateway, s.Log)
+	s.mfaProviderManager, err = newMfaProviderManager(s.config, s.uaaGateway, options, s.Log)
 	if err != nil {
 		return nil, err
 	}
 
-	s.userManager.clientToken, err = s.authManager.GetClientToken(config.ClientID, config.ClientSecret, "")
+	s.samlServiceProviderManager, err = newSamlServiceProviderManager(s.config, s.uaaGateway, options, s.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	s.tokenKeyManager, err = newTokenKeyManager(s.config, s.uaaGateway, options, s.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.tokenRefresher.AccessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// Resources that don't specify a zone are managed in the zone the session authenticated into
+	options.homeZoneId = parseTokenClaims(token).ZoneId
+	if options.homeZoneId == "" {
+		options.homeZoneId = defaultZoneId
+	}
+	s.zoneId = options.homeZoneId
 
 	return
 }
 
+// authenticator returns the function used to obtain an access token for the configured credentials.
+func (s *Session) authenticator(config *Config) func() (string, error) {
+
+	userClientID := config.ClientID
+	if userClientID == "" {
+		userClientID = defaultUserClientID
+	}
+
+	switch {
+	case config.AccessToken != "":
+		// A pre-issued token can't be renewed, so once it's rejected the only option is to fail
+		issued := false
+		return func() (string, error) {
+			if issued {
+				return "", errors.New("the configured access token was rejected or has expired")
+			}
+			issued = true
+			token := config.AccessToken
+			if !strings.HasPrefix(strings.ToLower(token), "bearer ") {
+				token = "bearer " + token
+			}
+			return token, nil
+		}
+	case config.Username != "":
+		return func() (string, error) {
+			return s.authManager.GetPasswordToken(userClientID, config.ClientSecret, config.Username, config.Password, config.ZoneSubdomain)
+		}
+	case config.JwtAssertion != "":
+		return func() (string, error) {
+			return s.authManager.GetJwtBearerToken(userClientID, config.ClientSecret, config.JwtAssertion, config.ZoneSubdomain)
+		}
+	default:
+		return func() (string, error) {
+			return s.authManager.GetClientToken(config.ClientID, config.ClientSecret, config.ZoneSubdomain)
+		}
+	}
+}
+
+// ZoneId returns the ID of the identity zone the session authenticated into.
+func (s *Session) ZoneId() string {
+	return s.zoneId
+}
+
+// ResolveZoneId returns the given zone ID, or the zone the session authenticated into if it's empty.
+func (s *Session) ResolveZoneId(zoneId string) string {
+	if zoneId == "" {
+		return s.zoneId
+	}
+	return zoneId
+}
+
 func (s *Session) UserManager() *UserManager {
 	return s.userManager
 }
@@ -107,10 +223,26 @@ func (s *Session) GroupManager() *GroupManager {
 	return s.groupManager
 }
 
+func (s *Session) IdentityProviderManager() *IdentityProviderManager {
+	return s.identityProviderManager
+}
+
 func (s *Session) IdentityZoneManager() *IdentityZoneManager {
 	return s.identityZoneManger
 }
 
+func (s *Session) MfaProviderManager() *MfaProviderManager {
+	return s.mfaProviderManager
+}
+
+func (s *Session) SamlServiceProviderManager() *SamlServiceProviderManager {
+	return s.samlServiceProviderManager
+}
+
+func (s *Session) TokenKeyManager() *TokenKeyManager {
+	return s.tokenKeyManager
+}
+
 func (s *Session) AuthManager() *AuthManager {
 	return s.authManager
 }
@@ -137,6 +269,15 @@ func (p *noopPersistor) Save(configuration.DataInterface) error {
 	return nil
 }
 
+func endpointWithSubDomain(endpoint, subDomain string) string {
+
+	if subDomain != "" {
+		endpoint = strings.Replace(endpoint, "http://", "http://"+subDomain+".", 1)
+		endpoint = strings.Replace(endpoint, "https://", "https://"+subDomain+".", 1)
+	}
+	return endpoint
+}
+
 func endpointAsURL(endpoint string) string {
 
 	endpoint = strings.TrimSuffix(endpoint, "/")
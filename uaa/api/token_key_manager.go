@@ -0,0 +1,65 @@
+package api
+
+import (
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/net"
+	"encoding/json"
+)
+
+type TokenKeyManager struct {
+	log *Logger
+	api *UaaApi
+}
+
+func newTokenKeyManager(config coreconfig.Reader, gateway net.Gateway, options *apiOptions, logger *Logger) (tkm *TokenKeyManager, err error) {
+
+	api, err := newUaaApi(config, gateway, options)
+	if err != nil {
+		return
+	}
+
+	tkm = &TokenKeyManager{
+		log: logger,
+		api: api,
+	}
+	return
+}
+
+// GetTokenKeys returns the keys used to verify the zone's tokens along with the raw JSON Web Key Set.
+func (manager *TokenKeyManager) GetTokenKeys(zoneId string) (*TokenKeys, string, error) {
+
+	jwks := json.RawMessage{}
+	if err := manager.api.WithZoneId(zoneId).Get("/token_keys", &jwks); err != nil {
+		return nil, "", err
+	}
+
+	tokenKeys := &TokenKeys{}
+	if err := json.Unmarshal(jwks, tokenKeys); err != nil {
+		return nil, "", err
+	}
+
+	return tokenKeys, string(jwks), nil
+}
+
+// GetActiveTokenKey returns the key used to verify the tokens the zone currently issues.
+func (manager *TokenKeyManager) GetActiveTokenKey(zoneId string) (tokenKey *TokenKey, err error) {
+
+	err = manager.api.WithZoneId(zoneId).Get("/token_key", &tokenKey)
+	return
+}
+
+// DTOs
+
+type TokenKeys struct {
+	Keys []TokenKey `json:"keys"`
+}
+
+type TokenKey struct {
+	Alg   string `json:"alg"`
+	E     string `json:"e,omitempty"`
+	Kid   string `json:"kid"`
+	Kty   string `json:"kty"`
+	N     string `json:"n,omitempty"`
+	Use   string `json:"use"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Tokens are renewed this long before they expire so that a request doesn't race the expiry.
+const tokenExpiryMargin = 30 * time.Second
+
+// tokenRefresher keeps the session's access token valid.  It's registered with the gateway, which calls
+// RefreshToken before every request, and is used by UaaApi to re-authenticate when UAA rejects a token.
+type tokenRefresher struct {
+	authenticate func() (string, error)
+	expiresAt    time.Time
+	log          *Logger
+	mutex        sync.Mutex
+	token        string
+}
+
+func newTokenRefresher(authenticate func() (string, error), logger *Logger) *tokenRefresher {
+	return &tokenRefresher{
+		authenticate: authenticate,
+		log:          logger,
+	}
+}
+
+// AccessToken returns the current access token, authenticating first if there isn't one yet or it's about to expire.
+// Renewing the token up front means the gateway's RefreshToken won't fail a request half way, which would be mistaken
+// for a network error.
+func (r *tokenRefresher) AccessToken() (string, error) {
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.token == "" {
+		return r.refresh()
+	}
+	if r.isExpiring() {
+		r.log.DebugMessage("Access token expires at %s; re-authenticating", r.expiresAt)
+		return r.refresh()
+	}
+	return r.token, nil
+}
+
+// RefreshToken implements the gateway's token refresher.  Only tokens issued by this refresher are renewed; other
+// authorization headers, such as the basic credentials sent to the token endpoint, are passed through untouched.
+func (r *tokenRefresher) RefreshToken(authHeader string) (string, error) {
+
+	if !strings.HasPrefix(strings.ToLower(authHeader), "bearer ") {
+		return authHeader, nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if authHeader != r.token {
+		return authHeader, nil
+	}
+	if !r.isExpiring() {
+		return authHeader, nil
+	}
+
+	r.log.DebugMessage("Access token expires at %s; re-authenticating", r.expiresAt)
+	return r.refresh()
+}
+
+// ForceRefresh re-authenticates after UAA rejected the given token.  If the token has already been replaced by a
+// concurrent request the current token is returned instead.
+func (r *tokenRefresher) ForceRefresh(rejectedToken string) (string, error) {
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if rejectedToken != r.token {
+		return r.token, nil
+	}
+
+	r.log.DebugMessage("Access token was rejected; re-authenticating")
+	return r.refresh()
+}
+
+func (r *tokenRefresher) isExpiring() bool {
+	return !r.expiresAt.IsZero() && time.Until(r.expiresAt) <= tokenExpiryMargin
+}
+
+func (r *tokenRefresher) refresh() (string, error) {
+
+	token, err := r.authenticate()
+	if err != nil {
+		return "", err
+	}
+
+	r.token = token
+	r.expiresAt = parseTokenClaims(token).expiry()
+	return token, nil
+}
+
+type tokenClaims struct {
+	Exp    int64  `json:"exp"`
+	ZoneId string `json:"zid"`
+}
+
+// expiry returns when the token expires.  Opaque tokens have no known expiry and are only renewed once UAA rejects
+// them.
+func (c tokenClaims) expiry() time.Time {
+	if c.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.Exp, 0)
+}
+
+// parseTokenClaims reads the claims of a JWT access token without verifying it.  Opaque tokens have no claims.
+func parseTokenClaims(token string) (claims tokenClaims) {
+
+	if i := strings.Index(token, " "); i >= 0 {
+		token = token[i+1:]
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return
+	}
+
+	_ = json.Unmarshal(payload, &claims)
+	return
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// newJwt creates an unsigned bearer token with the given claims.
+func newJwt(claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return fmt.Sprintf("bearer %s.%s.%s", encode([]byte(`{"alg":"none"}`)), encode([]byte(claims)), encode([]byte("signature")))
+}
+
+func newJwtExpiringAt(expiresAt time.Time) string {
+	return newJwt(fmt.Sprintf(`{"exp": %d}`, expiresAt.Unix()))
+}
+
+// newTestRefresher creates a refresher that hands out the given tokens in turn.
+func newTestRefresher(tokens ...string) (*tokenRefresher, *int) {
+
+	calls := 0
+	return newTokenRefresher(func() (string, error) {
+		token := tokens[calls]
+		calls++
+		return token, nil
+	}, NewLogger(false, "")), &calls
+}
+
+func TestParseTokenClaims(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		token    string
+		expected tokenClaims
+	}{
+		{
+			name:     "jwt",
+			token:    newJwt(`{"exp": 1700000000, "zid": "my-zone"}`),
+			expected: tokenClaims{Exp: 1700000000, ZoneId: "my-zone"},
+		},
+		{
+			name:     "jwt without token type",
+			token:    newJwt(`{"exp": 1700000000}`)[len("bearer "):],
+			expected: tokenClaims{Exp: 1700000000},
+		},
+		{
+			name:     "padded payload",
+			token:    "bearer e30." + base64.URLEncoding.EncodeToString([]byte(`{"exp": 1700000000}`)) + ".c2ln",
+			expected: tokenClaims{Exp: 1700000000},
+		},
+		{
+			name:     "opaque",
+			token:    "bearer 0b7f7e3e1c9a4f6f8d5b2a1c3e4f5a6b",
+			expected: tokenClaims{},
+		},
+		{
+			name:     "malformed payload",
+			token:    "bearer e30.!!!.c2ln",
+			expected: tokenClaims{},
+		},
+		{
+			name:     "payload isn't json",
+			token:    "bearer e30." + base64.RawURLEncoding.EncodeToString([]byte("exp")) + ".c2ln",
+			expected: tokenClaims{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := parseTokenClaims(test.token); actual != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTokenClaims_expiry(t *testing.T) {
+
+	if expiry := (tokenClaims{}).expiry(); !expiry.IsZero() {
+		t.Errorf("expected a token without an exp claim to have no expiry, got %s", expiry)
+	}
+	if expiry := (tokenClaims{Exp: 1700000000}).expiry(); !expiry.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("expected the expiry to be read from the exp claim, got %s", expiry)
+	}
+}
+
+func TestTokenRefresher_AccessToken(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		first     string
+		refreshes bool
+	}{
+		{
+			name:      "valid",
+			first:     newJwtExpiringAt(time.Now().Add(time.Hour)),
+			refreshes: false,
+		},
+		{
+			name:      "expiring within the margin",
+			first:     newJwtExpiringAt(time.Now().Add(tokenExpiryMargin / 2)),
+			refreshes: true,
+		},
+		{
+			name:      "expired",
+			first:     newJwtExpiringAt(time.Now().Add(-time.Minute)),
+			refreshes: true,
+		},
+		{
+			name:      "opaque",
+			first:     "bearer opaque",
+			refreshes: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			second := newJwtExpiringAt(time.Now().Add(time.Hour))
+			refresher, calls := newTestRefresher(test.first, second)
+
+			if token, err := refresher.AccessToken(); err != nil || token != test.first {
+				t.Fatalf("expected the first token, got '%s' (%v)", token, err)
+			}
+
+			expected := test.first
+			if test.refreshes {
+				expected = second
+			}
+			if token, err := refresher.AccessToken(); err != nil || token != expected {
+				t.Errorf("expected token '%s', got '%s' (%v)", expected, token, err)
+			}
+			if test.refreshes && *calls != 2 || !test.refreshes && *calls != 1 {
+				t.Errorf("unexpected number of authentications: %d", *calls)
+			}
+		})
+	}
+}
+
+func TestTokenRefresher_RefreshToken(t *testing.T) {
+
+	expiring := newJwtExpiringAt(time.Now().Add(time.Second))
+	renewed := newJwtExpiringAt(time.Now().Add(time.Hour))
+	refresher, _ := newTestRefresher(expiring, renewed)
+
+	if _, err := refresher.AccessToken(); err != nil {
+		t.Fatal(err)
+	}
+
+	if header, err := refresher.RefreshToken("Basic Y2xpZW50OnNlY3JldA=="); err != nil || header != "Basic Y2xpZW50OnNlY3JldA==" {
+		t.Errorf("expected basic credentials to be passed through, got '%s' (%v)", header, err)
+	}
+	other := newJwt(fmt.Sprintf(`{"exp": %d, "zid": "other"}`, time.Now().Add(time.Second).Unix()))
+	if header, err := refresher.RefreshToken(other); err != nil || header != other {
+		t.Errorf("expected a token not issued by the refresher to be passed through, got '%s' (%v)", header, err)
+	}
+	if header, err := refresher.RefreshToken(expiring); err != nil || header != renewed {
+		t.Errorf("expected the expiring token to be renewed, got '%s' (%v)", header, err)
+	}
+	if header, err := refresher.RefreshToken(renewed); err != nil || header != renewed {
+		t.Errorf("expected the renewed token to be kept, got '%s' (%v)", header, err)
+	}
+}
+
+func TestTokenRefresher_ForceRefresh(t *testing.T) {
+
+	first := newJwtExpiringAt(time.Now().Add(time.Hour))
+	second := newJwtExpiringAt(time.Now().Add(2 * time.Hour))
+	refresher, calls := newTestRefresher(first, second)
+
+	if _, err := refresher.AccessToken(); err != nil {
+		t.Fatal(err)
+	}
+
+	if token, err := refresher.ForceRefresh(first); err != nil || token != second {
+		t.Errorf("expected the rejected token to be replaced, got '%s' (%v)", token, err)
+	}
+	// A request that was sent with the first token before it was replaced mustn't authenticate again
+	if token, err := refresher.ForceRefresh(first); err != nil || token != second {
+		t.Errorf("expected the current token, got '%s' (%v)", token, err)
+	}
+	if *calls != 2 {
+		t.Errorf("expected 2 authentications, got %d", *calls)
+	}
+}
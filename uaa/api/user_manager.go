@@ -4,7 +4,6 @@ import (
 	"code.cloudfoundry.org/cli/cf/net"
 	"encoding/json"
 	"fmt"
-	apiheaders "github.com/foundcloudry/terraform-provider-uaa/uaa/api/headers"
 	"net/http"
 	"net/url"
 
@@ -16,27 +15,37 @@ type UserManager struct {
 	log                 *Logger
 	api                 *UaaApi
 	identityZoneManager *IdentityZoneManager
-	clientToken         string
 	groupMap            map[string]map[string]string
 	defaultGroups       map[string]map[string]int
 }
 
 type UAAUser struct {
-	Id       string         `json:"id,omitempty"`
-	Username string         `json:"userName,omitempty"`
-	Password string         `json:"password,omitempty"`
-	Origin   string         `json:"origin,omitempty"`
-	Name     UAAUserName    `json:"name,omitempty"`
-	Emails   []UAAUserEmail `json:"emails,omitempty"`
-	Groups   []UAAUserGroup `json:"groups,omitempty"`
-	ZoneId   string         `json:"zoneId,omitempty"`
+	Id                   string               `json:"id,omitempty"`
+	ExternalId           string               `json:"externalId,omitempty"`
+	Username             string               `json:"userName,omitempty"`
+	Password             string               `json:"password,omitempty"`
+	Origin               string               `json:"origin,omitempty"`
+	Name                 UAAUserName          `json:"name,omitempty"`
+	DisplayName          string               `json:"displayName,omitempty"`
+	Emails               []UAAUserEmail       `json:"emails,omitempty"`
+	PhoneNumbers         []UAAUserPhoneNumber `json:"phoneNumbers,omitempty"`
+	Active               bool                 `json:"active"`
+	Verified             bool                 `json:"verified"`
+	Locale               string               `json:"locale,omitempty"`
+	Timezone             string               `json:"timezone,omitempty"`
+	Groups               []UAAUserGroup       `json:"groups,omitempty"`
+	ZoneId               string               `json:"zoneId,omitempty"`
+	PasswordLastModified string               `json:"passwordLastModified,omitempty"`
+	LastLogonTime        int64                `json:"lastLogonTime,omitempty"`
+	Meta                 *UAAMeta             `json:"meta,omitempty"`
 }
 
-type UAAUserResourceList struct {
-	Resources []UAAUser `json:"resources"`
+type UAAUserEmail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
 }
 
-type UAAUserEmail struct {
+type UAAUserPhoneNumber struct {
 	Value string `json:"value"`
 }
 
@@ -45,15 +54,23 @@ type UAAUserName struct {
 	FamilyName string `json:"familyName"`
 }
 
+// UAAUserStatus is the account status of a user.  UAA only allows unlocking an account and requiring a password change;
+// accounts are locked by the lockout policy and the password change requirement is cleared once the user has changed
+// their password.  The status can't be read, but UAA returns it when it's changed.
+type UAAUserStatus struct {
+	Locked                 *bool `json:"locked,omitempty"`
+	PasswordChangeRequired *bool `json:"passwordChangeRequired,omitempty"`
+}
+
 type UAAUserGroup struct {
 	Value   string `json:"value"`
 	Display string `json:"display"`
 	Type    string `json:"type"`
 }
 
-func newUserManager(config coreconfig.Reader, gateway net.Gateway, identityZoneManager *IdentityZoneManager, logger *Logger) (um *UserManager, err error) {
+func newUserManager(config coreconfig.Reader, gateway net.Gateway, options *apiOptions, identityZoneManager *IdentityZoneManager, logger *Logger) (um *UserManager, err error) {
 
-	api, err := newUaaApi(config, gateway)
+	api, err := newUaaApi(config, gateway, options)
 	if err != nil {
 		return
 	}
@@ -80,13 +97,12 @@ func (um *UserManager) loadGroups(zoneId string) (err error) {
 	um.groupMap[zoneId] = make(map[string]string)
 	um.defaultGroups[zoneId] = make(map[string]int)
 
-	// Retrieve all groups
-	groupList := &UAAGroupResourceList{}
-	err = uaaApi.Get("/Groups", groupList)
+	// Retrieve all groups; only the ID and name are needed so skip the members
+	groups, err := listAll[UAAGroup](uaaApi, "/Groups", url.Values{"attributes": {"id,displayName"}})
 	if err != nil {
 		return
 	}
-	for _, r := range groupList.Resources {
+	for _, r := range groups {
 		um.groupMap[zoneId][r.DisplayName] = r.Id
 	}
 
@@ -122,32 +138,17 @@ func (um *UserManager) GetUser(id, zoneId string) (user *UAAUser, err error) {
 	return
 }
 
-func (um *UserManager) CreateUser(username, password, origin, givenName, familyName, email, zoneId string) (user *UAAUser, err error) {
+func (um *UserManager) CreateUser(userResource UAAUser, zoneId string) (user *UAAUser, err error) {
 
 	uaaApi := um.api.WithZoneId(zoneId)
 
-	userResource := UAAUser{
-		Username: username,
-		Password: password,
-		Origin:   origin,
-		Name: UAAUserName{
-			GivenName:  givenName,
-			FamilyName: familyName,
-		},
-	}
-	if len(email) > 0 {
-		userResource.Emails = append(userResource.Emails, UAAUserEmail{email})
-	} else {
-		userResource.Emails = append(userResource.Emails, UAAUserEmail{username})
-	}
-
 	user = &UAAUser{}
 	err = uaaApi.Post("/Users", userResource, user)
 	if err != nil {
 		switch httpErr := err.(type) {
 		case errors.HTTPError:
 			if httpErr.StatusCode() == http.StatusConflict {
-				err = errors.NewModelAlreadyExistsError("user", username)
+				err = errors.NewModelAlreadyExistsError("user", userResource.Username)
 			}
 		}
 	}
@@ -155,29 +156,28 @@ func (um *UserManager) CreateUser(username, password, origin, givenName, familyN
 	return
 }
 
-func (um *UserManager) UpdateUser(id, username, givenName, familyName, email, zoneId string) (user *UAAUser, err error) {
+// UpdateUser replaces the user's attributes.  The password, origin and groups can't be changed this way and are
+// ignored by UAA.  The user is merged into the one UAA currently has, so that attributes the provider doesn't model
+// are kept.
+func (um *UserManager) UpdateUser(id string, userResource UAAUser, version int, zoneId string) (user *UAAUser, err error) {
 
+	path := fmt.Sprintf("/Users/%s", id)
 	uaaApi := um.api.WithZoneId(zoneId)
 
-	userResource := UAAUser{
-		Username: username,
-		Name: UAAUserName{
-			GivenName:  givenName,
-			FamilyName: familyName,
-		},
+	current := json.RawMessage{}
+	if err = uaaApi.Get(path, &current); err != nil {
+		return
 	}
-	if len(email) > 0 {
-		userResource.Emails = append(userResource.Emails, UAAUserEmail{email})
-	} else {
-		userResource.Emails = append(userResource.Emails, UAAUserEmail{username})
+
+	merged, err := mergeManagedFields(current, userResource)
+	if err != nil {
+		return
 	}
 
 	user = &UAAUser{}
 	err = uaaApi.
-		WithHeaders(map[string]string{
-			apiheaders.IfMatch.String(): "*",
-		}).
-		Put(fmt.Sprintf("/Users/%s", id), userResource, user)
+		WithVersion(version).
+		Put(path, merged, user)
 
 	return
 }
@@ -189,25 +189,39 @@ func (um *UserManager) DeleteUser(id, zoneId string) error {
 		Delete(fmt.Sprintf("/Users/%s", id))
 }
 
-func (um *UserManager) ChangePassword(id, oldPassword, newPassword, zoneId string) (err error) {
-
-	uaaApi := um.api.WithZoneId(zoneId)
+func (um *UserManager) setStatus(id string, status UAAUserStatus, zoneId string) (*UAAUserStatus, error) {
 
-	body, err := json.Marshal(map[string]string{
-		"oldPassword": oldPassword,
-		"password":    newPassword,
-	})
-	if err != nil {
-		return
+	response := &UAAUserStatus{}
+	if err := um.api.WithZoneId(zoneId).Patch(fmt.Sprintf("/Users/%s/status", id), status, response); err != nil {
+		return nil, err
 	}
 
-	err = uaaApi.
-		WithHeaders(map[string]string{
-			apiheaders.Authorization.String(): um.clientToken,
-		}).
-		Put(fmt.Sprintf("/Users/%s/password", id), body, nil)
+	return response, nil
+}
 
-	return
+// UnlockUser unlocks an account that was locked after too many failed logins.
+func (um *UserManager) UnlockUser(id, zoneId string) (*UAAUserStatus, error) {
+
+	locked := false
+	return um.setStatus(id, UAAUserStatus{Locked: &locked}, zoneId)
+}
+
+// RequirePasswordChange forces the user to change their password the next time they log in.
+func (um *UserManager) RequirePasswordChange(id, zoneId string) (*UAAUserStatus, error) {
+
+	passwordChangeRequired := true
+	return um.setStatus(id, UAAUserStatus{PasswordChangeRequired: &passwordChangeRequired}, zoneId)
+}
+
+// ChangePassword resets the user's password without the old password, which is only allowed with an admin token of the
+// user's zone.
+func (um *UserManager) ChangePassword(id, newPassword, zoneId string) error {
+
+	data := map[string]string{
+		"password": newPassword,
+	}
+
+	return um.api.WithZoneId(zoneId).Put(fmt.Sprintf("/Users/%s/password", id), data, nil)
 }
 
 func (um *UserManager) UpdateRoles(id string, scopesToDelete, scopesToAdd []string, origin, zoneId string) (err error) {
@@ -246,21 +260,22 @@ func (um *UserManager) UpdateRoles(id string, scopesToDelete, scopesToAdd []stri
 	return
 }
 
+// List returns the users matching the options, reading every page of results.
+func (um *UserManager) List(options ListOptions, zoneId string) ([]UAAUser, error) {
+	return listAll[UAAUser](um.api.WithZoneId(zoneId), "/Users", options.query())
+}
+
 func (um *UserManager) FindByUsername(username, zoneId string) (user UAAUser, err error) {
 
 	uaaApi := um.api.WithZoneId(zoneId)
 
-	usernameFilter := url.QueryEscape(fmt.Sprintf(`userName Eq "%s"`, username))
-	path := fmt.Sprintf("/Users?filter=%s", usernameFilter)
-
-	userResourceList := &UAAUserResourceList{}
-	err = uaaApi.Get(path, userResourceList)
+	users, err := listAll[UAAUser](uaaApi, "/Users", filterQuery(`userName Eq "%s"`, username))
 	if err != nil {
 		return
 	}
 
-	if len(userResourceList.Resources) > 0 {
-		user = userResourceList.Resources[0]
+	if len(users) > 0 {
+		user = users[0]
 	} else {
 		err = errors.NewModelNotFoundError("User", username)
 	}
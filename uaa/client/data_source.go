@@ -53,5 +53,15 @@ func readDataSource(ctx context.Context, data *schema.ResourceData, i interface{
 	data.Set(fields.CreatedWith.String(), client.CreatedWith)
 	data.Set(fields.ApprovalsDeleted.String(), client.ApprovalsDeleted)
 
+	if err := MapJwtConfigToResource(&client, data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	meta, err := um.GetClientMeta(client.ClientID, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	data.Set(fields.Meta.String(), MapMetaToResource(meta))
+
 	return nil
 }
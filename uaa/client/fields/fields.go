@@ -11,13 +11,19 @@ const (
 	AutoApprove
 	ClientId
 	ClientSecret
+	Clients
 	CreatedWith
+	Jwks
+	JwksUri
+	JwtCreds
+	Meta
 	Name
 	RedirectUri
 	RefreshTokenValidity
 	RequiredUserGroups
 	ResourceIds
 	Scope
+	SecondaryClientSecret
 	TokenSalt
 	ZoneId
 )
@@ -40,8 +46,18 @@ func (s ClientField) String() string {
 		return "client_id"
 	case ClientSecret:
 		return "client_secret"
+	case Clients:
+		return "clients"
 	case CreatedWith:
 		return "created_with"
+	case Jwks:
+		return "jwks"
+	case JwksUri:
+		return "jwks_uri"
+	case JwtCreds:
+		return "jwt_creds"
+	case Meta:
+		return "meta"
 	case Name:
 		return "name"
 	case RedirectUri:
@@ -54,6 +70,8 @@ func (s ClientField) String() string {
 		return "resource_ids"
 	case Scope:
 		return "scope"
+	case SecondaryClientSecret:
+		return "secondary_client_secret"
 	case TokenSalt:
 		return "token_salt"
 	case ZoneId:
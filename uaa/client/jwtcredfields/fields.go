@@ -0,0 +1,21 @@
+package jwtcredfields
+
+type JwtCredField int64
+
+const (
+	Audience JwtCredField = iota
+	Issuer
+	Subject
+)
+
+func (s JwtCredField) String() string {
+	switch s {
+	case Audience:
+		return "audience"
+	case Issuer:
+		return "issuer"
+	case Subject:
+		return "subject"
+	}
+	return "unknown"
+}
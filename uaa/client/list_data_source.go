@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/client/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/listoptions"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ListDataSource = &schema.Resource{
+	Schema:      listDataSourceSchema,
+	ReadContext: readListDataSource,
+}
+
+var listDataSourceSchema = listoptions.Schema(map[string]*schema.Schema{
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	},
+	fields.Clients.String(): {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Resource{Schema: listedClientSchema},
+	},
+})
+
+var listedClientSchema = map[string]*schema.Schema{
+	fields.ClientId.String():             {Type: schema.TypeString, Computed: true},
+	fields.Name.String():                 {Type: schema.TypeString, Computed: true},
+	fields.AuthorizedGrantTypes.String(): computedStringSet(),
+	fields.RedirectUri.String():          computedStringSet(),
+	fields.Scope.String():                computedStringSet(),
+	fields.ResourceIds.String():          computedStringSet(),
+	fields.Authorities.String():          computedStringSet(),
+	fields.AutoApprove.String():          computedStringSet(),
+	fields.AllowProviders.String():       computedStringSet(),
+	fields.RequiredUserGroups.String():   computedStringSet(),
+	fields.AccessTokenValidity.String():  {Type: schema.TypeInt, Computed: true},
+	fields.RefreshTokenValidity.String(): {Type: schema.TypeInt, Computed: true},
+}
+
+func computedStringSet() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      util.ResourceStringHash,
+	}
+}
+
+func readListDataSource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	options := listoptions.MapResourceToListOptions(data)
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	clients, err := session.ClientManager().List(options, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Listed %d clients in zone '%s'", len(clients), zoneId)
+
+	data.SetId(listoptions.Id(zoneId, options))
+	data.Set(fields.ZoneId.String(), zoneId)
+	data.Set(fields.Clients.String(), MapClientsToList(clients))
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/client/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/client/jwtcredfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/client/metafields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// MapJwtConfigToResource sets the client's JWT configuration, clearing it when the client doesn't authenticate with
+// JWTs so that keys removed outside of terraform are detected.
+func MapJwtConfigToResource(client *api.UAAClient, data *schema.ResourceData) error {
+
+	config, err := client.JwtConfig()
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		config = &api.UAAClientJwtConfig{}
+	}
+
+	jwks := ""
+	if len(config.Jwks) > 0 {
+		jwks = util.NormalizeJson(string(config.Jwks))
+	}
+
+	data.Set(fields.JwksUri.String(), config.JwksUri)
+	data.Set(fields.Jwks.String(), jwks)
+	data.Set(fields.JwtCreds.String(), MapJwtCredentialsToResource(config.JwtCreds))
+
+	return nil
+}
+
+func MapResourceToJwtCredentials(data *schema.Set) []api.UAAClientJwtCredential {
+
+	var credentials []api.UAAClientJwtCredential
+	for _, v := range data.List() {
+		c := v.(map[string]interface{})
+		credentials = append(credentials, api.UAAClientJwtCredential{
+			Issuer:   c[jwtcredfields.Issuer.String()].(string),
+			Subject:  c[jwtcredfields.Subject.String()].(string),
+			Audience: c[jwtcredfields.Audience.String()].(string),
+		})
+	}
+	return credentials
+}
+
+func MapJwtCredentialsToResource(credentials []api.UAAClientJwtCredential) []map[string]interface{} {
+
+	var data []map[string]interface{}
+	for _, c := range credentials {
+		data = append(data, map[string]interface{}{
+			jwtcredfields.Issuer.String():   c.Issuer,
+			jwtcredfields.Subject.String():  c.Subject,
+			jwtcredfields.Audience.String(): c.Audience,
+		})
+	}
+	return data
+}
+
+func MapResourceToMeta(data *schema.ResourceData) api.UAAClientMeta {
+
+	meta := api.UAAClientMeta{}
+	if list := data.Get(fields.Meta.String()).([]interface{}); len(list) == 1 && list[0] != nil {
+		m := list[0].(map[string]interface{})
+		meta.AppLaunchUrl = m[metafields.AppLaunchUrl.String()].(string)
+		meta.ShowOnHomePage = m[metafields.ShowOnHomePage.String()].(bool)
+		meta.AppIcon = m[metafields.AppIcon.String()].(string)
+		meta.ClientName = m[metafields.ClientName.String()].(string)
+	}
+	return meta
+}
+
+// MapMetaToResource returns the `meta` block, which is left out when the client has no app launcher metadata.
+func MapMetaToResource(meta *api.UAAClientMeta) []map[string]interface{} {
+
+	if meta == nil || meta.IsEmpty() {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			metafields.AppLaunchUrl.String():   meta.AppLaunchUrl,
+			metafields.ShowOnHomePage.String(): meta.ShowOnHomePage,
+			metafields.AppIcon.String():        meta.AppIcon,
+			metafields.ClientName.String():     meta.ClientName,
+		},
+	}
+}
+
+func MapClientsToList(clients []api.UAAClient) []map[string]interface{} {
+
+	stringSet := func(values []string) *schema.Set {
+		return schema.NewSet(util.ResourceStringHash, util.ToInterface(values))
+	}
+
+	list := []map[string]interface{}{}
+	for _, client := range clients {
+		list = append(list, map[string]interface{}{
+			fields.ClientId.String():             client.ClientID,
+			fields.Name.String():                 client.Name,
+			fields.AuthorizedGrantTypes.String(): stringSet(client.AuthorizedGrantTypes),
+			fields.RedirectUri.String():          stringSet(client.RedirectURI),
+			fields.Scope.String():                stringSet(client.Scope),
+			fields.ResourceIds.String():          stringSet(client.ResourceIds),
+			fields.Authorities.String():          stringSet(client.Authorities),
+			fields.AutoApprove.String():          stringSet(client.AutoApprove),
+			fields.AllowProviders.String():       stringSet(client.AllowedProviders),
+			fields.RequiredUserGroups.String():   stringSet(client.RequiredUserGroups),
+			fields.AccessTokenValidity.String():  client.AccessTokenValidity,
+			fields.RefreshTokenValidity.String(): client.RefreshTokenValidity,
+		})
+	}
+	return list
+}
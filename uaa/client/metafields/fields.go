@@ -0,0 +1,24 @@
+package metafields
+
+type MetaField int64
+
+const (
+	AppIcon MetaField = iota
+	AppLaunchUrl
+	ClientName
+	ShowOnHomePage
+)
+
+func (s MetaField) String() string {
+	switch s {
+	case AppIcon:
+		return "app_icon"
+	case AppLaunchUrl:
+		return "app_launch_url"
+	case ClientName:
+		return "client_name"
+	case ShowOnHomePage:
+		return "show_on_home_page"
+	}
+	return "unknown"
+}
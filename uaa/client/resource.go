@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/client/fields"
 	"github.com/foundcloudry/terraform-provider-uaa/util"
@@ -15,6 +17,9 @@ var Resource = &schema.Resource{
 	ReadContext:   readResource,
 	UpdateContext: updateResource,
 	DeleteContext: deleteResource,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
 }
 
 func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -40,9 +45,11 @@ func createResource(ctx context.Context, data *schema.ResourceData, i interface{
 		TokenSalt:            data.Get(fields.TokenSalt.String()).(string),
 		CreatedWith:          data.Get(fields.CreatedWith.String()).(string),
 		ApprovalsDeleted:     data.Get(fields.ApprovalsDeleted.String()).(bool),
+		JwksUri:              data.Get(fields.JwksUri.String()).(string),
+		Jwks:                 json.RawMessage(data.Get(fields.Jwks.String()).(string)),
 	}
 
-	zoneId := data.Get(fields.ZoneId.String()).(string)
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
 	um := session.ClientManager()
 	client, err := um.Create(client, zoneId)
 	if err != nil {
@@ -51,6 +58,29 @@ func createResource(ctx context.Context, data *schema.ResourceData, i interface{
 	session.Log.DebugMessage("New client created: %# v", client)
 
 	data.SetId(client.ClientID)
+	data.Set(fields.ZoneId.String(), zoneId)
+
+	if secondarySecret := data.Get(fields.SecondaryClientSecret.String()).(string); secondarySecret != "" {
+		if err := um.AddSecret(client.ClientID, secondarySecret, zoneId); err != nil {
+			return diag.FromErr(err)
+		}
+		session.Log.DebugMessage("Secondary secret for client with id '%s' added.", client.ClientID)
+	}
+
+	for _, credential := range MapResourceToJwtCredentials(data.Get(fields.JwtCreds.String()).(*schema.Set)) {
+		if err := um.AddClientJwtCredential(client.ClientID, credential, zoneId); err != nil {
+			return diag.FromErr(err)
+		}
+		session.Log.DebugMessage("JWT credential for client with id '%s' added: %# v", client.ClientID, credential)
+	}
+
+	if meta := MapResourceToMeta(data); !meta.IsEmpty() {
+		updated, err := um.UpdateClientMeta(client.ClientID, meta, zoneId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		session.Log.DebugMessage("Metadata for client with id '%s' updated: %# v", client.ClientID, updated)
+	}
 
 	return nil
 }
@@ -68,7 +98,11 @@ func readResource(ctx context.Context, data *schema.ResourceData, i interface{})
 
 	client, err := um.GetClient(id, zoneId)
 	if err != nil {
-		data.SetId("")
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("Client with Id '%s' no longer exists; removing it from state", id)
+			data.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	session.Log.DebugMessage("Client with Id '%s' retrieved: %# v", id, client)
@@ -98,6 +132,16 @@ func readResource(ctx context.Context, data *schema.ResourceData, i interface{})
 	data.Set(fields.ApprovalsDeleted.String(), client.ApprovalsDeleted)
 	data.Set(fields.RequiredUserGroups.String(), schema.NewSet(util.ResourceStringHash, util.ToInterface(client.RequiredUserGroups)))
 
+	if err := MapJwtConfigToResource(client, data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	meta, err := um.GetClientMeta(id, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	data.Set(fields.Meta.String(), MapMetaToResource(meta))
+
 	return nil
 }
 
@@ -153,18 +197,137 @@ func updateResource(ctx context.Context, data *schema.ResourceData, i interface{
 		session.Log.DebugMessage("Client updated: %# v", nclient)
 	}
 
-	updateSecret, oldSecret, newSecret := util.GetResourceChange("client_secret", data)
-	if updateSecret {
-		err := um.ChangeSecret(id, oldSecret, newSecret, zoneId)
+	if err := updateSecrets(session, id, zoneId, data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateJwtConfig(session, id, zoneId, data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if data.HasChange(fields.Meta.String()) {
+		meta, err := um.UpdateClientMeta(id, MapResourceToMeta(data), zoneId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		session.Log.DebugMessage("Metadata for client with id '%s' updated: %# v", id, meta)
+	}
+
+	return nil
+}
+
+// updateSecrets rotates the client's secrets in three phases driven by `secondary_client_secret`:
+//
+//  1. setting it adds the new secret next to the current one, so that both are accepted;
+//  2. consumers are deployed with the new secret;
+//  3. moving the new secret to `client_secret` and clearing `secondary_client_secret` deletes the old secret.
+//
+// Any other change replaces the client's secrets outright.
+func updateSecrets(session *api.Session, id, zoneId string, data *schema.ResourceData) error {
+
+	um := session.ClientManager()
+	updateSecret, oldSecret, newSecret := util.GetResourceChange(fields.ClientSecret.String(), data)
+	updateSecondary, oldSecondary, newSecondary := util.GetResourceChange(fields.SecondaryClientSecret.String(), data)
+
+	if !updateSecret && !updateSecondary {
+		return nil
+	}
+
+	if oldSecondary != "" && newSecondary == "" && newSecret == oldSecondary {
+		if err := um.DeleteOldSecret(id, zoneId); err != nil {
+			return err
+		}
+		session.Log.DebugMessage("Old secret for client with id '%s' deleted.", id)
+		return nil
+	}
+
+	// Replacing the secret also drops a secondary secret, which has to be added again if it's still wanted
+	replaced := updateSecret || oldSecondary != ""
+	if replaced {
+		if err := um.ChangeSecret(id, oldSecret, newSecret, zoneId); err != nil {
+			return err
+		}
 		session.Log.DebugMessage("Secret for client with id '%s' updated.", id)
 	}
 
+	if newSecondary != "" && (replaced || updateSecondary) {
+		if err := um.AddSecret(id, newSecondary, zoneId); err != nil {
+			return err
+		}
+		session.Log.DebugMessage("Secondary secret for client with id '%s' added.", id)
+	}
+
+	return nil
+}
+
+// updateJwtConfig replaces the keys the client's JWTs are verified with, and adds or removes the JWT credentials that
+// have changed.
+func updateJwtConfig(session *api.Session, id, zoneId string, data *schema.ResourceData) error {
+
+	um := session.ClientManager()
+
+	if data.HasChanges(fields.JwksUri.String(), fields.Jwks.String()) {
+		oldJwksUri, newJwksUri := data.GetChange(fields.JwksUri.String())
+		oldJwks, newJwks := data.GetChange(fields.Jwks.String())
+
+		if newJwksUri.(string) == "" && newJwks.(string) == "" {
+			if err := um.DeleteClientJwt(id, oldJwksUri.(string), oldJwks.(string), zoneId); err != nil && !api.IsNotFound(err) {
+				return err
+			}
+			session.Log.DebugMessage("JWT keys for client with id '%s' deleted.", id)
+		} else {
+			if err := um.UpdateClientJwt(id, newJwksUri.(string), newJwks.(string), zoneId); err != nil {
+				return err
+			}
+			session.Log.DebugMessage("JWT keys for client with id '%s' updated.", id)
+		}
+	}
+
+	if data.HasChange(fields.JwtCreds.String()) {
+		o, n := data.GetChange(fields.JwtCreds.String())
+		oldCreds, newCreds := o.(*schema.Set), n.(*schema.Set)
+
+		for _, credential := range MapResourceToJwtCredentials(oldCreds.Difference(newCreds)) {
+			if err := um.DeleteClientJwtCredential(id, credential, zoneId); err != nil && !api.IsNotFound(err) {
+				return err
+			}
+			session.Log.DebugMessage("JWT credential for client with id '%s' deleted: %# v", id, credential)
+		}
+		for _, credential := range MapResourceToJwtCredentials(newCreds.Difference(oldCreds)) {
+			if err := um.AddClientJwtCredential(id, credential, zoneId); err != nil {
+				return err
+			}
+			session.Log.DebugMessage("JWT credential for client with id '%s' added: %# v", id, credential)
+		}
+	}
+
 	return nil
 }
 
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId, clientId, err := util.ParseImportId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := session.ClientManager().FindByClientID(clientId, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(client.ClientID)
+	// The zone isn't returned by the API for clients, so it has to come from the import ID.
+	data.Set(fields.ZoneId.String(), zoneId)
+
+	return []*schema.ResourceData{data}, nil
+}
+
 func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	session := i.(*api.Session)
 	if session == nil {
@@ -174,7 +337,9 @@ func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{
 	id := data.Id()
 	zoneId := data.Get(fields.ZoneId.String()).(string)
 	um := session.ClientManager()
-	_ = um.DeleteClient(id, zoneId)
+	if err := um.DeleteClient(id, zoneId); err != nil && !api.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
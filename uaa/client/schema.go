@@ -2,8 +2,11 @@ package client
 
 import (
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/client/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/client/jwtcredfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/client/metafields"
 	"github.com/foundcloudry/terraform-provider-uaa/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var clientSchema = map[string]*schema.Schema{
@@ -16,6 +19,31 @@ var clientSchema = map[string]*schema.Schema{
 		Optional:  true,
 		Sensitive: true,
 	},
+	fields.SecondaryClientSecret.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		RequiredWith: []string{fields.ClientSecret.String()},
+	},
+	fields.JwksUri.String(): {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ValidateFunc:  validation.IsURLWithHTTPS,
+		ConflictsWith: []string{fields.ClientSecret.String(), fields.Jwks.String()},
+	},
+	fields.Jwks.String(): {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: util.SuppressEquivalentJson,
+		ConflictsWith:    []string{fields.ClientSecret.String(), fields.JwksUri.String()},
+	},
+	fields.JwtCreds.String(): {
+		Type:          schema.TypeSet,
+		Optional:      true,
+		Elem:          &schema.Resource{Schema: jwtCredSchema},
+		ConflictsWith: []string{fields.ClientSecret.String()},
+	},
 	fields.AuthorizedGrantTypes.String(): {
 		Type:     schema.TypeSet,
 		Required: true,
@@ -88,13 +116,56 @@ var clientSchema = map[string]*schema.Schema{
 		Elem:     &schema.Schema{Type: schema.TypeString},
 		Set:      util.ResourceStringHash,
 	},
+	fields.Meta.String(): {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     &schema.Resource{Schema: metaSchema},
+	},
 	fields.ZoneId.String(): {
 		Type:     schema.TypeString,
 		ForceNew: true,
 		Optional: true,
-		// We don't get the zoneId back in the response for clients, so we'll default it instead
-		// of relying on it being computed.
-		Default: "uaa",
+		// We don't get the zoneId back in the response for clients, so it's computed from the zone the provider
+		// authenticated into when it's not set.
+		Computed: true,
+	},
+}
+
+var jwtCredSchema = map[string]*schema.Schema{
+	jwtcredfields.Issuer.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	jwtcredfields.Subject.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	jwtcredfields.Audience.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+}
+
+var metaSchema = map[string]*schema.Schema{
+	metafields.AppLaunchUrl.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+	},
+	metafields.ShowOnHomePage.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	metafields.AppIcon.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsBase64,
+	},
+	metafields.ClientName.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
 	},
 }
 
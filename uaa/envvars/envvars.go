@@ -3,18 +3,30 @@ package envvars
 type EnvironmentVariables int64
 
 const (
-	UaaAuthUrl EnvironmentVariables = iota
+	UaaAccessToken EnvironmentVariables = iota
+	UaaAuthUrl
 	UaaClientId
 	UaaDebug
 	UaaDialTimeout
 	UaaClientSecret
+	UaaForceOverwrite
+	UaaJwtAssertion
 	UaaLoginUrl
+	UaaPageSize
+	UaaPassword
+	UaaRetryBaseBackoff
+	UaaRetryMaxAttempts
+	UaaRetryMaxBackoff
 	UaaSkipSslValidation
 	UaaTrace
+	UaaUsername
+	UaaZoneSubdomain
 )
 
 func (s EnvironmentVariables) String() string {
 	switch s {
+	case UaaAccessToken:
+		return "UAA_ACCESS_TOKEN"
 	case UaaAuthUrl:
 		return "UAA_AUTH_URL"
 	case UaaClientId:
@@ -25,12 +37,30 @@ func (s EnvironmentVariables) String() string {
 		return "UAA_DEBUG"
 	case UaaDialTimeout:
 		return "UAA_DIAL_TIMEOUT"
+	case UaaForceOverwrite:
+		return "UAA_FORCE_OVERWRITE"
+	case UaaJwtAssertion:
+		return "UAA_JWT_ASSERTION"
 	case UaaLoginUrl:
 		return "UAA_LOGIN_URL"
+	case UaaPageSize:
+		return "UAA_PAGE_SIZE"
+	case UaaPassword:
+		return "UAA_PASSWORD"
+	case UaaRetryBaseBackoff:
+		return "UAA_RETRY_BASE_BACKOFF"
+	case UaaRetryMaxAttempts:
+		return "UAA_RETRY_MAX_ATTEMPTS"
+	case UaaRetryMaxBackoff:
+		return "UAA_RETRY_MAX_BACKOFF"
 	case UaaSkipSslValidation:
 		return "UAA_SKIP_SSL_VALIDATION"
 	case UaaTrace:
 		return "UAA_TRACE"
+	case UaaUsername:
+		return "UAA_USERNAME"
+	case UaaZoneSubdomain:
+		return "UAA_ZONE_SUBDOMAIN"
 	}
 	return "unknown"
 }
@@ -32,6 +32,7 @@ func readDataSource(ctx context.Context, data *schema.ResourceData, i interface{
 
 	data.SetId(group.Id)
 	data.Set(fields.Description.String(), group.Description)
+	data.Set(fields.Version.String(), group.Meta.GetVersion())
 	data.Set(fields.ZoneId.String(), group.ZoneId)
 
 	return nil
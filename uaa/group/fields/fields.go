@@ -5,6 +5,9 @@ type GroupField int64
 const (
 	Description GroupField = iota
 	DisplayName
+	Groups
+	Id
+	Version
 	ZoneId
 )
 
@@ -14,6 +17,12 @@ func (s GroupField) String() string {
 		return "description"
 	case DisplayName:
 		return "display_name"
+	case Groups:
+		return "groups"
+	case Id:
+		return "id"
+	case Version:
+		return "version"
 	case ZoneId:
 		return "zone_id"
 	}
@@ -0,0 +1,59 @@
+package group
+
+import (
+	"context"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/group/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/listoptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ListDataSource = &schema.Resource{
+	Schema:      listDataSourceSchema,
+	ReadContext: readListDataSource,
+}
+
+var listDataSourceSchema = listoptions.Schema(map[string]*schema.Schema{
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	},
+	fields.Groups.String(): {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Resource{Schema: listedGroupSchema},
+	},
+})
+
+var listedGroupSchema = map[string]*schema.Schema{
+	fields.Id.String():          {Type: schema.TypeString, Computed: true},
+	fields.DisplayName.String(): {Type: schema.TypeString, Computed: true},
+	fields.Description.String(): {Type: schema.TypeString, Computed: true},
+	fields.Version.String():     {Type: schema.TypeInt, Computed: true},
+	fields.ZoneId.String():      {Type: schema.TypeString, Computed: true},
+}
+
+func readListDataSource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	options := listoptions.MapResourceToListOptions(data)
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	groups, err := session.GroupManager().List(options, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Listed %d groups in zone '%s'", len(groups), zoneId)
+
+	data.SetId(listoptions.Id(zoneId, options))
+	data.Set(fields.ZoneId.String(), zoneId)
+	data.Set(fields.Groups.String(), MapGroupsToList(groups))
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package group
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/group/fields"
+)
+
+func MapGroupsToList(groups []api.UAAGroup) []map[string]interface{} {
+
+	list := []map[string]interface{}{}
+	for _, group := range groups {
+		list = append(list, map[string]interface{}{
+			fields.Id.String():          group.Id,
+			fields.DisplayName.String(): group.DisplayName,
+			fields.Description.String(): group.Description,
+			fields.Version.String():     group.Meta.GetVersion(),
+			fields.ZoneId.String():      group.ZoneId,
+		})
+	}
+	return list
+}
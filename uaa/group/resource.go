@@ -2,6 +2,7 @@ package group
 
 import (
 	"context"
+	"fmt"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/group/fields"
 	"github.com/foundcloudry/terraform-provider-uaa/util"
@@ -15,6 +16,9 @@ var Resource = &schema.Resource{
 	ReadContext:   readResource,
 	UpdateContext: updateResource,
 	DeleteContext: deleteResource,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
 }
 
 func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -36,6 +40,7 @@ func createResource(ctx context.Context, data *schema.ResourceData, i interface{
 	session.Log.DebugMessage("New group created: %# v", group)
 
 	data.SetId(group.Id)
+	data.Set(fields.Version.String(), group.Meta.GetVersion())
 	data.Set(fields.ZoneId.String(), group.ZoneId)
 
 	return nil
@@ -54,12 +59,17 @@ func readResource(ctx context.Context, data *schema.ResourceData, i interface{})
 
 	group, err := gm.GetGroup(id, zoneId)
 	if err != nil {
-		data.SetId("")
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("Group with GUID '%s' no longer exists; removing it from state", id)
+			data.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	session.Log.DebugMessage("Group with GUID '%s' retrieved: %# v", id, group)
 
 	data.Set(fields.Description.String(), group.Description)
+	data.Set(fields.Version.String(), group.Meta.GetVersion())
 	data.Set(fields.ZoneId.String(), group.ZoneId)
 
 	return nil
@@ -83,16 +93,46 @@ func updateResource(ctx context.Context, data *schema.ResourceData, i interface{
 	updateGroup = updateGroup || changed
 
 	if updateGroup {
-		group, err := gm.UpdateGroup(id, displayName, description, zoneId)
+		version := data.Get(fields.Version.String()).(int)
+		group, err := gm.UpdateGroup(id, displayName, description, version, zoneId)
 		if err != nil {
+			if api.IsConflict(err) {
+				return util.ConflictDiagnostics("group", id, err)
+			}
 			return diag.FromErr(err)
 		}
 		session.Log.DebugMessage("Group updated: %# v", group)
+
+		data.Set(fields.Version.String(), group.Meta.GetVersion())
 	}
 
 	return nil
 }
 
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId, displayName, err := util.ParseImportId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := session.GroupManager().FindByDisplayName(displayName, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(group.Id)
+	data.Set(fields.DisplayName.String(), group.DisplayName)
+	data.Set(fields.ZoneId.String(), group.ZoneId)
+
+	return []*schema.ResourceData{data}, nil
+}
+
 func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 
 	session := i.(*api.Session)
@@ -103,8 +143,7 @@ func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{
 	id := data.Id()
 	zoneId := data.Get(fields.ZoneId.String()).(string)
 	gm := session.GroupManager()
-	err := gm.DeleteGroup(id, zoneId) //nolint error is authorized here to allow not existing to be deleted without error
-	if err != nil {
+	if err := gm.DeleteGroup(id, zoneId); err != nil && !api.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -14,6 +14,10 @@ var groupSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
 	},
+	fields.Version.String(): {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
 	fields.ZoneId.String(): {
 		Type:     schema.TypeString,
 		ForceNew: true,
@@ -0,0 +1,27 @@
+package fields
+
+type GroupMappingField int64
+
+const (
+	ExternalGroup GroupMappingField = iota
+	GroupId
+	GroupName
+	Origin
+	ZoneId
+)
+
+func (s GroupMappingField) String() string {
+	switch s {
+	case ExternalGroup:
+		return "external_group"
+	case GroupId:
+		return "group_id"
+	case GroupName:
+		return "group_name"
+	case Origin:
+		return "origin"
+	case ZoneId:
+		return "zone_id"
+	}
+	return "unknown"
+}
@@ -0,0 +1,152 @@
+package groupmapping
+
+import (
+	"context"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmapping/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+)
+
+var Resource = &schema.Resource{
+	Schema:        groupMappingSchema,
+	CreateContext: createResource,
+	ReadContext:   readResource,
+	DeleteContext: deleteResource,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
+}
+
+func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	gm := session.GroupManager()
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	// Mappings are always made by group ID, so look the group up when it's referenced by name
+	groupId := data.Get(fields.GroupId.String()).(string)
+	if groupId == "" {
+		group, err := gm.FindByDisplayName(data.Get(fields.GroupName.String()).(string), zoneId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		groupId = group.Id
+	}
+
+	mapping, err := gm.MapExternalGroup(api.UAAGroupMapping{
+		GroupId:       groupId,
+		ExternalGroup: data.Get(fields.ExternalGroup.String()).(string),
+		Origin:        data.Get(fields.Origin.String()).(string),
+	}, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("New group mapping created: %# v", mapping)
+
+	data.SetId(mappingId(mapping.GroupId, mapping.Origin, mapping.ExternalGroup))
+	data.Set(fields.GroupId.String(), mapping.GroupId)
+
+	return readResource(ctx, data, i)
+}
+
+func readResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	gm := session.GroupManager()
+	groupId := data.Get(fields.GroupId.String()).(string)
+	externalGroup := data.Get(fields.ExternalGroup.String()).(string)
+	origin := data.Get(fields.Origin.String()).(string)
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	mapping, err := gm.FindExternalGroupMapping(groupId, externalGroup, origin, zoneId)
+	if err != nil {
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("Group mapping '%s' no longer exists; removing it from state", data.Id())
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Group mapping '%s' retrieved: %# v", data.Id(), mapping)
+
+	group, err := gm.GetGroup(groupId, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set(fields.GroupId.String(), mapping.GroupId)
+	data.Set(fields.GroupName.String(), group.DisplayName)
+	data.Set(fields.ExternalGroup.String(), mapping.ExternalGroup)
+	data.Set(fields.Origin.String(), mapping.Origin)
+	data.Set(fields.ZoneId.String(), group.ZoneId)
+
+	return nil
+}
+
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId, id, err := util.ParseImportId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// The external group comes last as it is the only part that may contain a `/`, e.g. a SAML attribute URI
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid import ID '%s', expected '<zone_id>/<origin>/<group_name>/<external_group>'", data.Id())
+	}
+	origin, groupName, externalGroup := parts[0], parts[1], parts[2]
+
+	group, err := session.GroupManager().FindByDisplayName(groupName, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(mappingId(group.Id, origin, externalGroup))
+	data.Set(fields.GroupId.String(), group.Id)
+	data.Set(fields.ExternalGroup.String(), externalGroup)
+	data.Set(fields.Origin.String(), origin)
+	data.Set(fields.ZoneId.String(), group.ZoneId)
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	groupId := data.Get(fields.GroupId.String()).(string)
+	externalGroup := data.Get(fields.ExternalGroup.String()).(string)
+	origin := data.Get(fields.Origin.String()).(string)
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	if err := session.GroupManager().UnmapExternalGroup(groupId, externalGroup, origin, zoneId); err != nil && !api.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func mappingId(groupId, origin, externalGroup string) string {
+	return fmt.Sprintf("%s/%s/%s", groupId, origin, externalGroup)
+}
@@ -0,0 +1,45 @@
+package groupmapping
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmapping/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var groupReferences = []string{
+	fields.GroupId.String(),
+	fields.GroupName.String(),
+}
+
+var groupMappingSchema = map[string]*schema.Schema{
+	fields.ExternalGroup.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	fields.GroupId.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ExactlyOneOf: groupReferences,
+	},
+	fields.GroupName.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ExactlyOneOf: groupReferences,
+	},
+	fields.Origin.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "ldap",
+	},
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		ForceNew: true,
+		Optional: true,
+		Computed: true,
+	},
+}
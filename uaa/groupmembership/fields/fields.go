@@ -0,0 +1,24 @@
+package fields
+
+type GroupMembershipField int64
+
+const (
+	Authoritative GroupMembershipField = iota
+	GroupId
+	Member
+	ZoneId
+)
+
+func (s GroupMembershipField) String() string {
+	switch s {
+	case Authoritative:
+		return "authoritative"
+	case GroupId:
+		return "group_id"
+	case Member:
+		return "member"
+	case ZoneId:
+		return "zone_id"
+	}
+	return "unknown"
+}
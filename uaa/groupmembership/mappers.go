@@ -0,0 +1,34 @@
+package groupmembership
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmembership/memberfields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func MapResourceToMembers(data *schema.Set) []api.UAAGroupMember {
+
+	var members []api.UAAGroupMember
+	for _, v := range data.List() {
+		m := v.(map[string]interface{})
+		members = append(members, api.UAAGroupMember{
+			Origin: m[memberfields.Origin.String()].(string),
+			Type:   m[memberfields.Type.String()].(string),
+			Value:  m[memberfields.Value.String()].(string),
+		})
+	}
+	return members
+}
+
+func MapMembersToResource(members []api.UAAGroupMember) []map[string]interface{} {
+
+	var data []map[string]interface{}
+	for _, m := range members {
+		data = append(data, map[string]interface{}{
+			memberfields.Origin.String(): m.Origin,
+			memberfields.Type.String():   m.Type,
+			memberfields.Value.String():  m.Value,
+		})
+	}
+	return data
+}
@@ -0,0 +1,21 @@
+package memberfields
+
+type MemberField int64
+
+const (
+	Origin MemberField = iota
+	Type
+	Value
+)
+
+func (s MemberField) String() string {
+	switch s {
+	case Origin:
+		return "origin"
+	case Type:
+		return "type"
+	case Value:
+		return "value"
+	}
+	return "unknown"
+}
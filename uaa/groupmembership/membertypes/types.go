@@ -0,0 +1,23 @@
+package membertypes
+
+type MemberType int64
+
+const (
+	Group MemberType = iota
+	User
+)
+
+var MemberTypes = []string{
+	Group.String(),
+	User.String(),
+}
+
+func (s MemberType) String() string {
+	switch s {
+	case Group:
+		return "GROUP"
+	case User:
+		return "USER"
+	}
+	return "unknown"
+}
@@ -0,0 +1,225 @@
+package groupmembership
+
+import (
+	"context"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmembership/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const additiveIdSuffix = "additive"
+
+var Resource = &schema.Resource{
+	Schema:        groupMembershipSchema,
+	CreateContext: createResource,
+	ReadContext:   readResource,
+	UpdateContext: updateResource,
+	DeleteContext: deleteResource,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
+}
+
+func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	groupId := data.Get(fields.GroupId.String()).(string)
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+	authoritative := data.Get(fields.Authoritative.String()).(bool)
+	members := MapResourceToMembers(data.Get(fields.Member.String()).(*schema.Set))
+
+	if err := syncMembers(session.GroupManager(), groupId, zoneId, nil, members, authoritative); err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Members of group with GUID '%s' set: %# v", groupId, members)
+
+	data.SetId(membershipId(groupId, authoritative))
+
+	return readResource(ctx, data, i)
+}
+
+func readResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	gm := session.GroupManager()
+	groupId := data.Get(fields.GroupId.String()).(string)
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	group, err := gm.GetGroup(groupId, zoneId)
+	if err != nil {
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("Group with GUID '%s' no longer exists; removing its members from state", groupId)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	members, err := gm.GetMembers(groupId, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Members of group with GUID '%s' retrieved: %# v", groupId, members)
+
+	// In additive mode other members of the group are owned by someone else, so only track the ones we manage
+	if !data.Get(fields.Authoritative.String()).(bool) {
+		managed := memberValues(MapResourceToMembers(data.Get(fields.Member.String()).(*schema.Set)))
+		var managedMembers []api.UAAGroupMember
+		for _, m := range members {
+			if _, ok := managed[m.Value]; ok {
+				managedMembers = append(managedMembers, m)
+			}
+		}
+		members = managedMembers
+	}
+
+	data.Set(fields.GroupId.String(), groupId)
+	data.Set(fields.ZoneId.String(), group.ZoneId)
+	data.Set(fields.Member.String(), MapMembersToResource(members))
+
+	return nil
+}
+
+func updateResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	groupId := data.Get(fields.GroupId.String()).(string)
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+	authoritative := data.Get(fields.Authoritative.String()).(bool)
+
+	oldMembers, newMembers := data.GetChange(fields.Member.String())
+	previous := MapResourceToMembers(oldMembers.(*schema.Set))
+	members := MapResourceToMembers(newMembers.(*schema.Set))
+
+	if err := syncMembers(session.GroupManager(), groupId, zoneId, previous, members, authoritative); err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Members of group with GUID '%s' updated: %# v", groupId, members)
+
+	if data.HasChange(fields.Authoritative.String()) {
+		data.SetId(membershipId(groupId, authoritative))
+	}
+
+	return readResource(ctx, data, i)
+}
+
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId, groupId, err := util.ParseImportId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := session.GroupManager().GetGroup(groupId, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Imported memberships are authoritative so that the existing members are read into state
+	data.SetId(membershipId(group.Id, true))
+	data.Set(fields.GroupId.String(), group.Id)
+	data.Set(fields.ZoneId.String(), group.ZoneId)
+	data.Set(fields.Authoritative.String(), true)
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	groupId := data.Get(fields.GroupId.String()).(string)
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+	members := MapResourceToMembers(data.Get(fields.Member.String()).(*schema.Set))
+
+	// The members are gone with the group if it has been deleted already
+	if err := syncMembers(session.GroupManager(), groupId, zoneId, members, nil, false); err != nil && !api.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// syncMembers adds the members that are missing from the group and removes the previously managed members that are
+// no longer wanted.  When authoritative, any other member of the group is removed as well.
+func syncMembers(gm *api.GroupManager, groupId, zoneId string, previous, members []api.UAAGroupMember, authoritative bool) error {
+
+	current, err := gm.GetMembers(groupId, zoneId)
+	if err != nil {
+		return err
+	}
+
+	currentMembers := memberValues(current)
+	previousMembers := memberValues(previous)
+	wantedMembers := memberValues(members)
+
+	// A member whose type or origin changes is removed and added again, as UAA only identifies members by their value
+	for _, m := range current {
+		wanted, isWanted := wantedMembers[m.Value]
+		if isWanted && sameMember(m, wanted) {
+			continue
+		}
+		if _, managed := previousMembers[m.Value]; managed || authoritative || isWanted {
+			if err := gm.RemoveMember(groupId, m.Value, zoneId); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, m := range members {
+		if existing, exists := currentMembers[m.Value]; exists && sameMember(existing, m) {
+			continue
+		}
+		if _, err := gm.AddMember(groupId, m, zoneId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// membershipId identifies the membership.  A group only has one authoritative membership, which takes the GUID of the
+// group, while additive memberships are suffixed with their mode.
+func membershipId(groupId string, authoritative bool) string {
+
+	if authoritative {
+		return groupId
+	}
+	return fmt.Sprintf("%s/%s", groupId, additiveIdSuffix)
+}
+
+func memberValues(members []api.UAAGroupMember) map[string]api.UAAGroupMember {
+
+	values := make(map[string]api.UAAGroupMember, len(members))
+	for _, m := range members {
+		values[m.Value] = m
+	}
+	return values
+}
+
+func sameMember(a, b api.UAAGroupMember) bool {
+	return a.Type == b.Type && a.Origin == b.Origin
+}
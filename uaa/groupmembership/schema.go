@@ -0,0 +1,53 @@
+package groupmembership
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmembership/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmembership/memberfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmembership/membertypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var groupMembershipSchema = map[string]*schema.Schema{
+	fields.Authoritative.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	fields.GroupId.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	fields.Member.String(): {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: memberSchema,
+		},
+	},
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		ForceNew: true,
+		Optional: true,
+		Computed: true,
+	},
+}
+
+var memberSchema = map[string]*schema.Schema{
+	memberfields.Origin.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "uaa",
+	},
+	memberfields.Type.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      membertypes.User.String(),
+		ValidateFunc: validation.StringInSlice(membertypes.MemberTypes, false),
+	},
+	memberfields.Value.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+}
@@ -0,0 +1,36 @@
+package identityprovider
+
+import (
+	"context"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var DataSource = &schema.Resource{
+	Schema:      dataSourceSchema,
+	ReadContext: readDataSource,
+}
+
+func readDataSource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	ipm := session.IdentityProviderManager()
+
+	originKey := data.Get(fields.OriginKey.String()).(string)
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	identityProvider, err := ipm.FindByOriginKey(originKey, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	MapIdentityProviderToResource(identityProvider, data)
+
+	return nil
+}
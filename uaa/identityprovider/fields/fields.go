@@ -0,0 +1,54 @@
+package fields
+
+type IdentityProviderField int64
+
+const (
+	AddShadowUserOnLogin IdentityProviderField = iota
+	AttributeMappings
+	EmailDomain
+	ExternalGroupsWhitelist
+	IsActive
+	LdapConfig
+	Name
+	OauthConfig
+	OriginKey
+	ProviderDescription
+	SamlConfig
+	StoreCustomAttributes
+	Type
+	ZoneId
+)
+
+func (s IdentityProviderField) String() string {
+	switch s {
+	case AddShadowUserOnLogin:
+		return "add_shadow_user_on_login"
+	case AttributeMappings:
+		return "attribute_mappings"
+	case EmailDomain:
+		return "email_domain"
+	case ExternalGroupsWhitelist:
+		return "external_groups_whitelist"
+	case IsActive:
+		return "is_active"
+	case LdapConfig:
+		return "ldap_config"
+	case Name:
+		return "name"
+	case OauthConfig:
+		return "oauth_config"
+	case OriginKey:
+		return "origin_key"
+	case ProviderDescription:
+		return "provider_description"
+	case SamlConfig:
+		return "saml_config"
+	case StoreCustomAttributes:
+		return "store_custom_attributes"
+	case Type:
+		return "type"
+	case ZoneId:
+		return "zone_id"
+	}
+	return "unknown"
+}
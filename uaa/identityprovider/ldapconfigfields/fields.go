@@ -0,0 +1,72 @@
+package ldapconfigfields
+
+type LdapConfigField int64
+
+const (
+	AutoAddGroups LdapConfigField = iota
+	BaseUrl
+	BindPassword
+	BindUserDn
+	GroupRoleAttribute
+	GroupSearchBase
+	GroupSearchFilter
+	GroupSearchSubTree
+	LdapGroupFile
+	LdapProfileFile
+	MailAttributeName
+	MailSubstitute
+	MailSubstituteOverridesLdap
+	MaxGroupSearchDepth
+	Referral
+	SkipSslVerification
+	TlsConfiguration
+	UserDnPattern
+	UserSearchBase
+	UserSearchFilter
+)
+
+func (s LdapConfigField) String() string {
+	switch s {
+	case AutoAddGroups:
+		return "auto_add_groups"
+	case BaseUrl:
+		return "base_url"
+	case BindPassword:
+		return "bind_password"
+	case BindUserDn:
+		return "bind_user_dn"
+	case GroupRoleAttribute:
+		return "group_role_attribute"
+	case GroupSearchBase:
+		return "group_search_base"
+	case GroupSearchFilter:
+		return "group_search_filter"
+	case GroupSearchSubTree:
+		return "group_search_sub_tree"
+	case LdapGroupFile:
+		return "ldap_group_file"
+	case LdapProfileFile:
+		return "ldap_profile_file"
+	case MailAttributeName:
+		return "mail_attribute_name"
+	case MailSubstitute:
+		return "mail_substitute"
+	case MailSubstituteOverridesLdap:
+		return "mail_substitute_overrides_ldap"
+	case MaxGroupSearchDepth:
+		return "max_group_search_depth"
+	case Referral:
+		return "referral"
+	case SkipSslVerification:
+		return "skip_ssl_verification"
+	case TlsConfiguration:
+		return "tls_configuration"
+	case UserDnPattern:
+		return "user_dn_pattern"
+	case UserSearchBase:
+		return "user_search_base"
+	case UserSearchFilter:
+		return "user_search_filter"
+	}
+	return "unknown"
+}
@@ -0,0 +1,288 @@
+package identityprovider
+
+import (
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/ldapconfigfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/oauthconfigfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/providertypes"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/samlconfigfields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Mapper methods for mapping API objects to TF resources
+
+func MapIdentityProviderToResource(identityProvider *api.IdentityProvider, data *schema.ResourceData) {
+
+	data.SetId(identityProvider.Id)
+	data.Set(fields.IsActive.String(), identityProvider.IsActive)
+	data.Set(fields.Name.String(), identityProvider.Name)
+	data.Set(fields.OriginKey.String(), identityProvider.OriginKey)
+	data.Set(fields.Type.String(), identityProvider.Type)
+	data.Set(fields.ZoneId.String(), identityProvider.IdentityZoneId)
+
+	config := identityProvider.Config
+	if config == nil {
+		return
+	}
+
+	data.Set(fields.AddShadowUserOnLogin.String(), config.AddShadowUserOnLogin)
+	data.Set(fields.AttributeMappings.String(), mapAttributeMappingsToInterface(config.AttributeMappings))
+	data.Set(fields.EmailDomain.String(), config.EmailDomain)
+	data.Set(fields.ExternalGroupsWhitelist.String(), config.ExternalGroupsWhitelist)
+	data.Set(fields.ProviderDescription.String(), config.ProviderDescription)
+	data.Set(fields.StoreCustomAttributes.String(), config.StoreCustomAttributes)
+
+	switch identityProvider.Type {
+	case providertypes.Oauth2.String(), providertypes.Oidc.String():
+		data.Set(fields.OauthConfig.String(), mapOauthConfigToInterface(config, data))
+	case providertypes.Saml.String():
+		data.Set(fields.SamlConfig.String(), mapSamlConfigToInterface(config))
+	case providertypes.Ldap.String():
+		data.Set(fields.LdapConfig.String(), mapLdapConfigToInterface(config, data))
+	}
+}
+
+// Only string valued attribute mappings are supported; UAA also allows lists for some mappings (e.g. external groups)
+// but those can't be represented in a TF map of strings.
+func mapAttributeMappingsToInterface(data map[string]interface{}) map[string]interface{} {
+
+	mappings := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		if value, ok := v.(string); ok {
+			mappings[k] = value
+		}
+	}
+
+	return mappings
+}
+
+// UAA never returns the relying party secret so the value that is already in the state is kept.
+func mapOauthConfigToInterface(config *api.IdentityProviderConfig, data *schema.ResourceData) []map[string]interface{} {
+
+	secretKey := fmt.Sprintf("%s.0.%s", fields.OauthConfig.String(), oauthconfigfields.RelyingPartySecret.String())
+	relyingPartySecret := config.RelyingPartySecret
+	if relyingPartySecret == "" {
+		relyingPartySecret = data.Get(secretKey).(string)
+	}
+
+	return []map[string]interface{}{{
+		oauthconfigfields.AuthUrl.String():              config.AuthUrl,
+		oauthconfigfields.ClientAuthInBody.String():     boolValue(config.ClientAuthInBody),
+		oauthconfigfields.DiscoveryUrl.String():         config.DiscoveryUrl,
+		oauthconfigfields.Issuer.String():               config.Issuer,
+		oauthconfigfields.LinkText.String():             config.LinkText,
+		oauthconfigfields.LogoutUrl.String():            config.LogoutUrl,
+		oauthconfigfields.PasswordGrantEnabled.String(): boolValue(config.PasswordGrantEnabled),
+		oauthconfigfields.Pkce.String():                 boolValue(config.Pkce),
+		oauthconfigfields.RelyingPartyId.String():       config.RelyingPartyId,
+		oauthconfigfields.RelyingPartySecret.String():   relyingPartySecret,
+		oauthconfigfields.ResponseType.String():         config.ResponseType,
+		oauthconfigfields.Scopes.String():               config.Scopes,
+		oauthconfigfields.ShowLinkText.String():         boolValue(config.ShowLinkText),
+		oauthconfigfields.SkipSslValidation.String():    boolValue(config.SkipSslValidation),
+		oauthconfigfields.TokenKey.String():             config.TokenKey,
+		oauthconfigfields.TokenKeyUrl.String():          config.TokenKeyUrl,
+		oauthconfigfields.TokenUrl.String():             config.TokenUrl,
+		oauthconfigfields.UserInfoUrl.String():          config.UserInfoUrl,
+	}}
+}
+
+func mapSamlConfigToInterface(config *api.IdentityProviderConfig) []map[string]interface{} {
+
+	return []map[string]interface{}{{
+		samlconfigfields.AssertionConsumerIndex.String(): int64Value(config.AssertionConsumerIndex),
+		samlconfigfields.AuthnContext.String():           config.AuthnContext,
+		samlconfigfields.GroupMappingMode.String():       config.GroupMappingMode,
+		samlconfigfields.IconUrl.String():                config.IconUrl,
+		samlconfigfields.LinkText.String():               config.LinkText,
+		samlconfigfields.MetadataLocation.String():       config.MetadataLocation,
+		samlconfigfields.MetadataTrustCheck.String():     boolValue(config.MetadataTrustCheck),
+		samlconfigfields.NameId.String():                 config.NameId,
+		samlconfigfields.ShowSamlLink.String():           boolValue(config.ShowSamlLink),
+		samlconfigfields.SkipSslValidation.String():      boolValue(config.SkipSslValidation),
+	}}
+}
+
+// UAA never returns the bind password so the value that is already in the state is kept.
+func mapLdapConfigToInterface(config *api.IdentityProviderConfig, data *schema.ResourceData) []map[string]interface{} {
+
+	passwordKey := fmt.Sprintf("%s.0.%s", fields.LdapConfig.String(), ldapconfigfields.BindPassword.String())
+	bindPassword := config.BindPassword
+	if bindPassword == "" {
+		bindPassword = data.Get(passwordKey).(string)
+	}
+
+	return []map[string]interface{}{{
+		ldapconfigfields.AutoAddGroups.String():               boolValue(config.AutoAddGroups),
+		ldapconfigfields.BaseUrl.String():                     config.BaseUrl,
+		ldapconfigfields.BindPassword.String():                bindPassword,
+		ldapconfigfields.BindUserDn.String():                  config.BindUserDn,
+		ldapconfigfields.GroupRoleAttribute.String():          config.GroupRoleAttribute,
+		ldapconfigfields.GroupSearchBase.String():             config.GroupSearchBase,
+		ldapconfigfields.GroupSearchFilter.String():           config.GroupSearchFilter,
+		ldapconfigfields.GroupSearchSubTree.String():          boolValue(config.GroupSearchSubTree),
+		ldapconfigfields.LdapGroupFile.String():               config.LdapGroupFile,
+		ldapconfigfields.LdapProfileFile.String():             config.LdapProfileFile,
+		ldapconfigfields.MailAttributeName.String():           config.MailAttributeName,
+		ldapconfigfields.MailSubstitute.String():              config.MailSubstitute,
+		ldapconfigfields.MailSubstituteOverridesLdap.String(): boolValue(config.MailSubstituteOverridesLdap),
+		ldapconfigfields.MaxGroupSearchDepth.String():         int64Value(config.MaxGroupSearchDepth),
+		ldapconfigfields.Referral.String():                    config.Referral,
+		ldapconfigfields.SkipSslVerification.String():         boolValue(config.SkipSslVerification),
+		ldapconfigfields.TlsConfiguration.String():            config.TlsConfiguration,
+		ldapconfigfields.UserDnPattern.String():               config.UserDnPattern,
+		ldapconfigfields.UserSearchBase.String():              config.UserSearchBase,
+		ldapconfigfields.UserSearchFilter.String():            config.UserSearchFilter,
+	}}
+}
+
+// Mapper methods for mapping TF resources to API objects
+
+func MapResourceToIdentityProvider(data *schema.ResourceData) (*api.IdentityProvider, error) {
+
+	providerType := data.Get(fields.Type.String()).(string)
+	config := &api.IdentityProviderConfig{
+		AddShadowUserOnLogin:    data.Get(fields.AddShadowUserOnLogin.String()).(bool),
+		AttributeMappings:       data.Get(fields.AttributeMappings.String()).(map[string]interface{}),
+		EmailDomain:             mapSchemaSetToStringSlice(data.Get(fields.EmailDomain.String())),
+		ExternalGroupsWhitelist: mapSchemaSetToStringSlice(data.Get(fields.ExternalGroupsWhitelist.String())),
+		ProviderDescription:     data.Get(fields.ProviderDescription.String()).(string),
+		StoreCustomAttributes:   data.Get(fields.StoreCustomAttributes.String()).(bool),
+	}
+
+	var configBlock string
+	switch providerType {
+	case providertypes.Oauth2.String(), providertypes.Oidc.String():
+		configBlock = fields.OauthConfig.String()
+		if block := getBlock(data, configBlock); block != nil {
+			mapResourceToOauthConfig(block, config)
+		}
+	case providertypes.Saml.String():
+		configBlock = fields.SamlConfig.String()
+		if block := getBlock(data, configBlock); block != nil {
+			mapResourceToSamlConfig(block, config)
+		}
+	case providertypes.Ldap.String():
+		configBlock = fields.LdapConfig.String()
+		if block := getBlock(data, configBlock); block != nil {
+			mapResourceToLdapConfig(block, config)
+		}
+	}
+
+	if getBlock(data, configBlock) == nil {
+		return nil, fmt.Errorf("the `%s` block must be set for identity providers of type '%s'", configBlock, providerType)
+	}
+
+	return &api.IdentityProvider{
+		Id:             data.Id(),
+		IsActive:       data.Get(fields.IsActive.String()).(bool),
+		Name:           data.Get(fields.Name.String()).(string),
+		OriginKey:      data.Get(fields.OriginKey.String()).(string),
+		Type:           providerType,
+		IdentityZoneId: data.Get(fields.ZoneId.String()).(string),
+		Config:         config,
+	}, nil
+}
+
+func mapResourceToOauthConfig(block map[string]interface{}, config *api.IdentityProviderConfig) {
+
+	config.AuthUrl = block[oauthconfigfields.AuthUrl.String()].(string)
+	config.ClientAuthInBody = boolPointer(block[oauthconfigfields.ClientAuthInBody.String()])
+	config.DiscoveryUrl = block[oauthconfigfields.DiscoveryUrl.String()].(string)
+	config.Issuer = block[oauthconfigfields.Issuer.String()].(string)
+	config.LinkText = block[oauthconfigfields.LinkText.String()].(string)
+	config.LogoutUrl = block[oauthconfigfields.LogoutUrl.String()].(string)
+	config.PasswordGrantEnabled = boolPointer(block[oauthconfigfields.PasswordGrantEnabled.String()])
+	config.Pkce = boolPointer(block[oauthconfigfields.Pkce.String()])
+	config.RelyingPartyId = block[oauthconfigfields.RelyingPartyId.String()].(string)
+	config.RelyingPartySecret = block[oauthconfigfields.RelyingPartySecret.String()].(string)
+	config.ResponseType = block[oauthconfigfields.ResponseType.String()].(string)
+	config.Scopes = mapSchemaSetToStringSlice(block[oauthconfigfields.Scopes.String()])
+	config.ShowLinkText = boolPointer(block[oauthconfigfields.ShowLinkText.String()])
+	config.SkipSslValidation = boolPointer(block[oauthconfigfields.SkipSslValidation.String()])
+	config.TokenKey = block[oauthconfigfields.TokenKey.String()].(string)
+	config.TokenKeyUrl = block[oauthconfigfields.TokenKeyUrl.String()].(string)
+	config.TokenUrl = block[oauthconfigfields.TokenUrl.String()].(string)
+	config.UserInfoUrl = block[oauthconfigfields.UserInfoUrl.String()].(string)
+}
+
+func mapResourceToSamlConfig(block map[string]interface{}, config *api.IdentityProviderConfig) {
+
+	assertionConsumerIndex := int64(block[samlconfigfields.AssertionConsumerIndex.String()].(int))
+
+	config.AssertionConsumerIndex = &assertionConsumerIndex
+	config.AuthnContext = mapSchemaSetToStringSlice(block[samlconfigfields.AuthnContext.String()])
+	config.GroupMappingMode = block[samlconfigfields.GroupMappingMode.String()].(string)
+	config.IconUrl = block[samlconfigfields.IconUrl.String()].(string)
+	config.LinkText = block[samlconfigfields.LinkText.String()].(string)
+	config.MetadataLocation = block[samlconfigfields.MetadataLocation.String()].(string)
+	config.MetadataTrustCheck = boolPointer(block[samlconfigfields.MetadataTrustCheck.String()])
+	config.NameId = block[samlconfigfields.NameId.String()].(string)
+	config.ShowSamlLink = boolPointer(block[samlconfigfields.ShowSamlLink.String()])
+	config.SkipSslValidation = boolPointer(block[samlconfigfields.SkipSslValidation.String()])
+}
+
+func mapResourceToLdapConfig(block map[string]interface{}, config *api.IdentityProviderConfig) {
+
+	maxGroupSearchDepth := int64(block[ldapconfigfields.MaxGroupSearchDepth.String()].(int))
+
+	config.AutoAddGroups = boolPointer(block[ldapconfigfields.AutoAddGroups.String()])
+	config.BaseUrl = block[ldapconfigfields.BaseUrl.String()].(string)
+	config.BindPassword = block[ldapconfigfields.BindPassword.String()].(string)
+	config.BindUserDn = block[ldapconfigfields.BindUserDn.String()].(string)
+	config.GroupRoleAttribute = block[ldapconfigfields.GroupRoleAttribute.String()].(string)
+	config.GroupSearchBase = block[ldapconfigfields.GroupSearchBase.String()].(string)
+	config.GroupSearchFilter = block[ldapconfigfields.GroupSearchFilter.String()].(string)
+	config.GroupSearchSubTree = boolPointer(block[ldapconfigfields.GroupSearchSubTree.String()])
+	config.LdapGroupFile = block[ldapconfigfields.LdapGroupFile.String()].(string)
+	config.LdapProfileFile = block[ldapconfigfields.LdapProfileFile.String()].(string)
+	config.MailAttributeName = block[ldapconfigfields.MailAttributeName.String()].(string)
+	config.MailSubstitute = block[ldapconfigfields.MailSubstitute.String()].(string)
+	config.MailSubstituteOverridesLdap = boolPointer(block[ldapconfigfields.MailSubstituteOverridesLdap.String()])
+	config.MaxGroupSearchDepth = &maxGroupSearchDepth
+	config.Referral = block[ldapconfigfields.Referral.String()].(string)
+	config.SkipSslVerification = boolPointer(block[ldapconfigfields.SkipSslVerification.String()])
+	config.TlsConfiguration = block[ldapconfigfields.TlsConfiguration.String()].(string)
+	config.UserDnPattern = block[ldapconfigfields.UserDnPattern.String()].(string)
+	config.UserSearchBase = block[ldapconfigfields.UserSearchBase.String()].(string)
+	config.UserSearchFilter = block[ldapconfigfields.UserSearchFilter.String()].(string)
+}
+
+func getBlock(data *schema.ResourceData, field string) map[string]interface{} {
+
+	if list, ok := data.Get(field).([]interface{}); ok && len(list) == 1 {
+		if block, ok := list[0].(map[string]interface{}); ok {
+			return block
+		}
+	}
+	return nil
+}
+
+func mapSchemaSetToStringSlice(i interface{}) []string {
+
+	itemsRaw := i.(*schema.Set).List()
+	items := make([]string, len(itemsRaw))
+	for i, raw := range itemsRaw {
+		items[i] = raw.(string)
+	}
+
+	return items
+}
+
+func boolPointer(i interface{}) *bool {
+	b := i.(bool)
+	return &b
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+func int64Value(i *int64) int {
+	if i == nil {
+		return 0
+	}
+	return int(*i)
+}
@@ -0,0 +1,66 @@
+package oauthconfigfields
+
+type OauthConfigField int64
+
+const (
+	AuthUrl OauthConfigField = iota
+	ClientAuthInBody
+	DiscoveryUrl
+	Issuer
+	LinkText
+	LogoutUrl
+	PasswordGrantEnabled
+	Pkce
+	RelyingPartyId
+	RelyingPartySecret
+	ResponseType
+	Scopes
+	ShowLinkText
+	SkipSslValidation
+	TokenKey
+	TokenKeyUrl
+	TokenUrl
+	UserInfoUrl
+)
+
+func (s OauthConfigField) String() string {
+	switch s {
+	case AuthUrl:
+		return "auth_url"
+	case ClientAuthInBody:
+		return "client_auth_in_body"
+	case DiscoveryUrl:
+		return "discovery_url"
+	case Issuer:
+		return "issuer"
+	case LinkText:
+		return "link_text"
+	case LogoutUrl:
+		return "logout_url"
+	case PasswordGrantEnabled:
+		return "password_grant_enabled"
+	case Pkce:
+		return "pkce"
+	case RelyingPartyId:
+		return "relying_party_id"
+	case RelyingPartySecret:
+		return "relying_party_secret"
+	case ResponseType:
+		return "response_type"
+	case Scopes:
+		return "scopes"
+	case ShowLinkText:
+		return "show_link_text"
+	case SkipSslValidation:
+		return "skip_ssl_validation"
+	case TokenKey:
+		return "token_key"
+	case TokenKeyUrl:
+		return "token_key_url"
+	case TokenUrl:
+		return "token_url"
+	case UserInfoUrl:
+		return "user_info_url"
+	}
+	return "unknown"
+}
@@ -0,0 +1,36 @@
+package providertypes
+
+type ProviderType int64
+
+const (
+	Ldap ProviderType = iota
+	Oauth2
+	Oidc
+	Saml
+	Uaa
+)
+
+// ManagedProviderTypes are the provider types that can be created through the `uaa_identity_provider` resource.  The
+// internal `uaa` provider is created by UAA along with every identity zone.
+var ManagedProviderTypes = []string{
+	Ldap.String(),
+	Oauth2.String(),
+	Oidc.String(),
+	Saml.String(),
+}
+
+func (s ProviderType) String() string {
+	switch s {
+	case Ldap:
+		return "ldap"
+	case Oauth2:
+		return "oauth2.0"
+	case Oidc:
+		return "oidc1.0"
+	case Saml:
+		return "saml"
+	case Uaa:
+		return "uaa"
+	}
+	return "unknown"
+}
@@ -0,0 +1,141 @@
+package identityprovider
+
+import (
+	"context"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var Resource = &schema.Resource{
+	Schema:        identityProviderSchema,
+	CreateContext: createResource,
+	ReadContext:   readResource,
+	UpdateContext: updateResource,
+	DeleteContext: deleteResource,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
+}
+
+func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	ipm := session.IdentityProviderManager()
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	identityProvider, err := MapResourceToIdentityProvider(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := ipm.Create(identityProvider, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("New identity provider created: %# v", response)
+
+	MapIdentityProviderToResource(response, data)
+
+	return nil
+}
+
+func readResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	ipm := session.IdentityProviderManager()
+	id := data.Id()
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	response, err := ipm.FindById(id, zoneId)
+	if err != nil {
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("Identity provider with GUID '%s' no longer exists; removing it from state", id)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Identity provider with GUID '%s' retrieved: %# v", id, response)
+
+	MapIdentityProviderToResource(response, data)
+
+	return nil
+}
+
+func updateResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	ipm := session.IdentityProviderManager()
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	identityProvider, err := MapResourceToIdentityProvider(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := ipm.Update(data.Id(), identityProvider, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Identity provider updated: %# v", response)
+
+	MapIdentityProviderToResource(response, data)
+
+	return nil
+}
+
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId, originKey, err := util.ParseImportId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	identityProvider, err := session.IdentityProviderManager().FindByOriginKey(originKey, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(identityProvider.Id)
+	data.Set(fields.ZoneId.String(), identityProvider.IdentityZoneId)
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	ipm := session.IdentityProviderManager()
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	if err := ipm.Delete(data.Id(), zoneId); err != nil && !api.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
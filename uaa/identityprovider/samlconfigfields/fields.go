@@ -0,0 +1,42 @@
+package samlconfigfields
+
+type SamlConfigField int64
+
+const (
+	AssertionConsumerIndex SamlConfigField = iota
+	AuthnContext
+	GroupMappingMode
+	IconUrl
+	LinkText
+	MetadataLocation
+	MetadataTrustCheck
+	NameId
+	ShowSamlLink
+	SkipSslValidation
+)
+
+func (s SamlConfigField) String() string {
+	switch s {
+	case AssertionConsumerIndex:
+		return "assertion_consumer_index"
+	case AuthnContext:
+		return "authn_context"
+	case GroupMappingMode:
+		return "group_mapping_mode"
+	case IconUrl:
+		return "icon_url"
+	case LinkText:
+		return "link_text"
+	case MetadataLocation:
+		return "metadata_location"
+	case MetadataTrustCheck:
+		return "metadata_trust_check"
+	case NameId:
+		return "name_id"
+	case ShowSamlLink:
+		return "show_saml_link"
+	case SkipSslValidation:
+		return "skip_ssl_validation"
+	}
+	return "unknown"
+}
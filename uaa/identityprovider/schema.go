@@ -0,0 +1,373 @@
+package identityprovider
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/ldapconfigfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/oauthconfigfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/providertypes"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider/samlconfigfields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var configBlocks = []string{
+	fields.LdapConfig.String(),
+	fields.OauthConfig.String(),
+	fields.SamlConfig.String(),
+}
+
+var identityProviderSchema = map[string]*schema.Schema{
+	fields.AddShadowUserOnLogin.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	fields.AttributeMappings.String(): {
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	fields.EmailDomain.String(): {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	fields.ExternalGroupsWhitelist.String(): {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	fields.IsActive.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	fields.Name.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	fields.OriginKey.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	fields.ProviderDescription.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	fields.StoreCustomAttributes.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	fields.Type.String(): {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(providertypes.ManagedProviderTypes, false),
+	},
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		ForceNew: true,
+		Optional: true,
+		Computed: true,
+	},
+	fields.LdapConfig.String(): {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: configBlocks,
+		Elem: &schema.Resource{
+			Schema: LdapConfigSchema,
+		},
+	},
+	fields.OauthConfig.String(): {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: configBlocks,
+		Elem: &schema.Resource{
+			Schema: OauthConfigSchema,
+		},
+	},
+	fields.SamlConfig.String(): {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: configBlocks,
+		Elem: &schema.Resource{
+			Schema: SamlConfigSchema,
+		},
+	},
+}
+
+var OauthConfigSchema = map[string]*schema.Schema{
+	oauthconfigfields.AuthUrl.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	oauthconfigfields.ClientAuthInBody.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	oauthconfigfields.DiscoveryUrl.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	oauthconfigfields.Issuer.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	oauthconfigfields.LinkText.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	oauthconfigfields.LogoutUrl.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	oauthconfigfields.PasswordGrantEnabled.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	oauthconfigfields.Pkce.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	oauthconfigfields.RelyingPartyId.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	oauthconfigfields.RelyingPartySecret.String(): {
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	},
+	oauthconfigfields.ResponseType.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "code",
+	},
+	oauthconfigfields.Scopes.String(): {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	oauthconfigfields.ShowLinkText.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	oauthconfigfields.SkipSslValidation.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	oauthconfigfields.TokenKey.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	oauthconfigfields.TokenKeyUrl.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	oauthconfigfields.TokenUrl.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	oauthconfigfields.UserInfoUrl.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+}
+
+var SamlConfigSchema = map[string]*schema.Schema{
+	samlconfigfields.AssertionConsumerIndex.String(): {
+		Type:     schema.TypeInt,
+		Optional: true,
+		Default:  0,
+	},
+	samlconfigfields.AuthnContext.String(): {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	samlconfigfields.GroupMappingMode.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "EXPLICITLY_MAPPED",
+		ValidateFunc: validation.StringInSlice([]string{"EXPLICITLY_MAPPED", "AS_SCOPES"}, false),
+	},
+	samlconfigfields.IconUrl.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	samlconfigfields.LinkText.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	samlconfigfields.MetadataLocation.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	samlconfigfields.MetadataTrustCheck.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	samlconfigfields.NameId.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+	},
+	samlconfigfields.ShowSamlLink.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	samlconfigfields.SkipSslValidation.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+}
+
+var LdapConfigSchema = map[string]*schema.Schema{
+	ldapconfigfields.AutoAddGroups.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	ldapconfigfields.BaseUrl.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	ldapconfigfields.BindPassword.String(): {
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	},
+	ldapconfigfields.BindUserDn.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	ldapconfigfields.GroupRoleAttribute.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	ldapconfigfields.GroupSearchBase.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	ldapconfigfields.GroupSearchFilter.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	ldapconfigfields.GroupSearchSubTree.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	ldapconfigfields.LdapGroupFile.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "ldap/ldap-groups-null.xml",
+	},
+	ldapconfigfields.LdapProfileFile.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "ldap/ldap-search-and-bind.xml",
+	},
+	ldapconfigfields.MailAttributeName.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "mail",
+	},
+	ldapconfigfields.MailSubstitute.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	ldapconfigfields.MailSubstituteOverridesLdap.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	ldapconfigfields.MaxGroupSearchDepth.String(): {
+		Type:     schema.TypeInt,
+		Optional: true,
+		Default:  10,
+	},
+	ldapconfigfields.Referral.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "follow",
+		ValidateFunc: validation.StringInSlice([]string{"follow", "ignore", "throw"}, false),
+	},
+	ldapconfigfields.SkipSslVerification.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	ldapconfigfields.TlsConfiguration.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "none",
+		ValidateFunc: validation.StringInSlice([]string{"none", "simple", "external"}, false),
+	},
+	ldapconfigfields.UserDnPattern.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	ldapconfigfields.UserSearchBase.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	ldapconfigfields.UserSearchFilter.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+}
+
+// The only fields required to look up an existing identity provider are the `origin_key` and, optionally, the
+// `zone_id`.  All other fields are computed from the resource schema.
+var dataSourceSchema = mapSchemaForDataSource(identityProviderSchema)
+
+func mapSchemaForDataSource(originalSchema map[string]*schema.Schema) map[string]*schema.Schema {
+
+	dsSchema := map[string]*schema.Schema{}
+
+	for k, v := range originalSchema {
+		isOriginKey := k == fields.OriginKey.String()
+		isZoneId := k == fields.ZoneId.String()
+		dsSchema[k] = &schema.Schema{
+			Type:      v.Type,
+			Required:  isOriginKey,
+			Optional:  isZoneId,
+			Computed:  !isOriginKey,
+			Sensitive: v.Sensitive,
+			Elem:      v.Elem,
+		}
+		if v.Type == schema.TypeList {
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				dsSchema[k].Elem = &schema.Resource{
+					Schema: mapSchemaForDataSource(elem.Schema),
+				}
+			}
+		}
+	}
+
+	return dsSchema
+}
@@ -10,6 +10,8 @@ const (
 	BannerUrl
 	CompanyLogo
 	CompanyName
+	ConsentLink
+	ConsentText
 	Favicon
 	FooterLinks
 	FooterText
@@ -31,6 +33,10 @@ func (s BrandingField) String() string {
 		return "company_logo"
 	case CompanyName:
 		return "company_name"
+	case ConsentLink:
+		return "consent_link"
+	case ConsentText:
+		return "consent_text"
 	case Favicon:
 		return "favicon"
 	case FooterLinks:
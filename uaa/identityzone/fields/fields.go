@@ -4,10 +4,16 @@ type IdentityZoneField int64
 
 const (
 	AccountChooserEnabled IdentityZoneField = iota
+	AllowedUserGroups
 	Branding
+	CheckCustomerEnabled
+	CheckOriginEnabled
 	ClientSecretPolicy
 	CorsPolicy
+	DefaultIdentityProvider
 	DefaultUserGroups
+	Id
+	IdentityZones
 	InputPrompts
 	IsActive
 	IdpDiscoveryEnabled
@@ -15,15 +21,20 @@ const (
 	LogoutRedirectUrl
 	LogoutRedirectParam
 	LogoutAllowedRedirectUrls
+	LogoutDisableRedirectParam
 	HomeRedirectUrl
+	MaxUsers
 	MfaEnabled
 	MfaIdentityProviders
+	MfaProviderName
 	Name
+	NamePrefix
 	SamlConfig
 	SelfServeEnabled
 	SelfServeSignupUrl
 	SelfServePasswordResetUrl
 	SubDomain
+	SubDomainPrefix
 	TokenPolicy
 )
 
@@ -31,16 +42,28 @@ func (s IdentityZoneField) String() string {
 	switch s {
 	case AccountChooserEnabled:
 		return "account_chooser_enabled"
+	case AllowedUserGroups:
+		return "allowed_user_groups"
 	case Branding:
 		return "branding"
+	case CheckCustomerEnabled:
+		return "check_customer_enabled"
+	case CheckOriginEnabled:
+		return "check_origin_enabled"
 	case ClientSecretPolicy:
 		return "client_secret_policy"
 	case CorsPolicy:
 		return "cors_policy"
+	case DefaultIdentityProvider:
+		return "default_identity_provider"
 	case DefaultUserGroups:
 		return "default_user_groups"
 	case HomeRedirectUrl:
 		return "home_redirect_url"
+	case Id:
+		return "id"
+	case IdentityZones:
+		return "identity_zones"
 	case InputPrompts:
 		return "input_prompt"
 	case IdpDiscoveryEnabled:
@@ -55,12 +78,20 @@ func (s IdentityZoneField) String() string {
 		return "logout_redirect_param"
 	case LogoutAllowedRedirectUrls:
 		return "logout_allowed_redirect_urls"
+	case LogoutDisableRedirectParam:
+		return "logout_disable_redirect_param"
+	case MaxUsers:
+		return "max_users"
 	case MfaEnabled:
 		return "mfa_enabled"
 	case MfaIdentityProviders:
 		return "mfa_identity_providers"
+	case MfaProviderName:
+		return "mfa_provider_name"
 	case Name:
 		return "name"
+	case NamePrefix:
+		return "name_prefix"
 	case SamlConfig:
 		return "saml_config"
 	case SelfServeEnabled:
@@ -71,6 +102,8 @@ func (s IdentityZoneField) String() string {
 		return "self_serve_pw_reset_url"
 	case SubDomain:
 		return "sub_domain"
+	case SubDomainPrefix:
+		return "sub_domain_prefix"
 	case TokenPolicy:
 		return "token_policy"
 	}
@@ -0,0 +1,101 @@
+package identityzone
+
+import (
+	"context"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sort"
+	"strings"
+)
+
+var ListDataSource = &schema.Resource{
+	Schema:      listDataSourceSchema,
+	ReadContext: readListDataSource,
+}
+
+// UAA doesn't support SCIM filters for identity zones, so they can only be matched on their name and subdomain
+var listDataSourceSchema = map[string]*schema.Schema{
+	fields.IdentityZones.String(): {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Resource{Schema: listedIdentityZoneSchema},
+	},
+	fields.Name.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	fields.NamePrefix.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	fields.SubDomain.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	fields.SubDomainPrefix.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+}
+
+var listedIdentityZoneSchema = map[string]*schema.Schema{
+	fields.Id.String():        {Type: schema.TypeString, Computed: true},
+	fields.Name.String():      {Type: schema.TypeString, Computed: true},
+	fields.SubDomain.String(): {Type: schema.TypeString, Computed: true},
+	fields.IsActive.String():  {Type: schema.TypeBool, Computed: true},
+}
+
+func readListDataSource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	identityZones, err := session.IdentityZoneManager().List()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var matching []api.IdentityZone
+	for _, identityZone := range identityZones {
+		if matches(data, identityZone.Name, fields.Name, fields.NamePrefix) &&
+			matches(data, identityZone.SubDomain, fields.SubDomain, fields.SubDomainPrefix) {
+			matching = append(matching, identityZone)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		return matching[i].Id < matching[j].Id
+	})
+	session.Log.DebugMessage("Listed %d identity zones, of which %d match", len(identityZones), len(matching))
+
+	data.SetId(listDataSourceId(data))
+	data.Set(fields.IdentityZones.String(), MapIdentityZonesToList(matching))
+
+	return nil
+}
+
+// matches reports whether the value equals the exact argument and starts with the prefix argument, where they are set.
+func matches(data *schema.ResourceData, value string, exact, prefix fields.IdentityZoneField) bool {
+
+	if v := data.Get(exact.String()).(string); v != "" && v != value {
+		return false
+	}
+	return strings.HasPrefix(value, data.Get(prefix.String()).(string))
+}
+
+func listDataSourceId(data *schema.ResourceData) string {
+
+	key := strings.Join([]string{
+		data.Get(fields.Name.String()).(string),
+		data.Get(fields.NamePrefix.String()).(string),
+		data.Get(fields.SubDomain.String()).(string),
+		data.Get(fields.SubDomainPrefix.String()).(string),
+	}, "\n")
+
+	return fmt.Sprintf("%d", util.ResourceStringHash(key))
+}
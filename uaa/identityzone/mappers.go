@@ -1,6 +1,7 @@
 package identityzone
 
 import (
+	"fmt"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/brandingfields"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/clientsecretpolicyfields"
@@ -11,6 +12,7 @@ import (
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/inputpromptfields"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/samlconfigfields"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/samlkeyfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/signingkeyfields"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/tokenpolicyfields"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -28,15 +30,17 @@ func MapIdentityZoneToResource(identityZone *api.IdentityZone, data *schema.Reso
 	if identityZone.Config != nil {
 		data.Set(fields.ClientSecretPolicy.String(), mapIdentityZoneClientSecretPolicyToInterface(identityZone.Config.ClientSecretPolicy))
 		data.Set(fields.CorsPolicy.String(), mapIdentityZoneCorsPolicyToInterface(identityZone.Config.CorsPolicy))
+		data.Set(fields.DefaultIdentityProvider.String(), identityZone.Config.DefaultIdentityProvider)
 		data.Set(fields.IdpDiscoveryEnabled.String(), &identityZone.Config.IdpDiscoveryEnabled)
 		data.Set(fields.InputPrompts.String(), mapIdentityZoneInputPromptsToInterface(identityZone.Config.InputPrompts))
 		data.Set(fields.IssuerUrl.String(), &identityZone.Config.IssuerUrl)
 		data.Set(fields.SamlConfig.String(), mapIdentityZoneSamlConfigToInterface(identityZone.Config.Saml))
-		data.Set(fields.TokenPolicy.String(), mapIdentityZoneTokenPolicyToInterface(identityZone.Config.TokenPolicy))
+		data.Set(fields.TokenPolicy.String(), mapIdentityZoneTokenPolicyToInterface(identityZone.Config.TokenPolicy, getSigningKeys(data)))
 
 		if identityZone.Config.MfaConfig != nil {
 			data.Set(fields.MfaEnabled.String(), identityZone.Config.MfaConfig.IsEnabled)
 			data.Set(fields.MfaIdentityProviders.String(), identityZone.Config.MfaConfig.IdentityProviders)
+			data.Set(fields.MfaProviderName.String(), identityZone.Config.MfaConfig.ProviderName)
 		}
 
 		if identityZone.Config.Links != nil {
@@ -47,6 +51,7 @@ func MapIdentityZoneToResource(identityZone *api.IdentityZone, data *schema.Reso
 					data.Set(fields.LogoutRedirectParam.String(), identityZone.Config.Links.Logout.RedirectParameterName)
 					data.Set(fields.LogoutRedirectUrl.String(), identityZone.Config.Links.Logout.RedirectUrl)
 					data.Set(fields.LogoutAllowedRedirectUrls.String(), identityZone.Config.Links.Logout.AllowedRedirectUrls)
+					data.Set(fields.LogoutDisableRedirectParam.String(), identityZone.Config.Links.Logout.DisableRedirectParameter)
 				}
 
 				if identityZone.Config.Links.SelfService != nil {
@@ -59,6 +64,10 @@ func MapIdentityZoneToResource(identityZone *api.IdentityZone, data *schema.Reso
 
 		if identityZone.Config.UserConfig != nil {
 			data.Set(fields.DefaultUserGroups.String(), &identityZone.Config.UserConfig.DefaultGroups)
+			data.Set(fields.AllowedUserGroups.String(), identityZone.Config.UserConfig.AllowedGroups)
+			data.Set(fields.CheckCustomerEnabled.String(), identityZone.Config.UserConfig.CheckCustomerEnabled)
+			data.Set(fields.CheckOriginEnabled.String(), identityZone.Config.UserConfig.CheckOriginEnabled)
+			data.Set(fields.MaxUsers.String(), identityZone.Config.UserConfig.MaxUsers)
 		}
 
 		if identityZone.Config.Branding != nil {
@@ -72,6 +81,11 @@ func mapIdentityZoneBrandingToInterface(data *api.IdentityZoneBrandingConfig) []
 		return nil
 	}
 
+	var consentText, consentLink string
+	if data.Consent != nil {
+		consentText, consentLink = data.Consent.Text, data.Consent.Link
+	}
+
 	return []map[string]interface{}{{
 		brandingfields.BannerBackgroundColor.String(): data.Banner.BackgroundColor,
 		brandingfields.BannerLogo.String():            data.Banner.Logo,
@@ -80,6 +94,8 @@ func mapIdentityZoneBrandingToInterface(data *api.IdentityZoneBrandingConfig) []
 		brandingfields.BannerUrl.String():             data.Banner.Url,
 		brandingfields.CompanyName.String():           data.CompanyName,
 		brandingfields.CompanyLogo.String():           data.CompanyLogo,
+		brandingfields.ConsentLink.String():           consentLink,
+		brandingfields.ConsentText.String():           consentText,
 		brandingfields.Favicon.String():               data.Favicon,
 		brandingfields.FooterText.String():            data.FooterText,
 		brandingfields.FooterLinks.String():           data.Favicon,
@@ -177,7 +193,7 @@ func mapIdentityZoneClientSecretPolicyToInterface(data *api.IdentityZoneClientSe
 	}}
 }
 
-func mapIdentityZoneTokenPolicyToInterface(data *api.IdentityZoneTokenPolicy) []map[string]interface{} {
+func mapIdentityZoneTokenPolicyToInterface(data *api.IdentityZoneTokenPolicy, signingKeys []interface{}) []map[string]interface{} {
 
 	if data == nil {
 		return nil
@@ -190,9 +206,53 @@ func mapIdentityZoneTokenPolicyToInterface(data *api.IdentityZoneTokenPolicy) []
 		tokenpolicyfields.IsRefreshTokenUnique.String(): data.IsRefreshTokenUnique,
 		tokenpolicyfields.RefreshTokenFormat.String():   data.RefreshTokenFormat,
 		tokenpolicyfields.RefreshTokenTtl.String():      data.RefreshTokenTtl,
+		tokenpolicyfields.SigningKeys.String():          mapIdentityZoneTokenKeysToInterface(data.Keys, signingKeys),
 	}}
 }
 
+// UAA doesn't return the signing key material when reading a zone, so the keys held in state are kept for every key ID
+// that UAA still knows about.  If UAA doesn't list any keys the state is kept as is.
+func mapIdentityZoneTokenKeysToInterface(data map[string]*api.IdentityZoneTokenKey, signingKeys []interface{}) []interface{} {
+
+	if len(data) == 0 {
+		return signingKeys
+	}
+
+	known := make(map[string]interface{}, len(signingKeys))
+	for _, k := range signingKeys {
+		if key, ok := k.(map[string]interface{}); ok {
+			known[key[signingkeyfields.KeyId.String()].(string)] = key
+		}
+	}
+
+	var keys []interface{}
+	for keyId, key := range data {
+		if k, ok := known[keyId]; ok {
+			keys = append(keys, k)
+		} else if key.SigningKey != "" {
+			keys = append(keys, map[string]interface{}{
+				signingkeyfields.Algorithm.String():   key.SigningAlg,
+				signingkeyfields.Certificate.String(): key.SigningCert,
+				signingkeyfields.KeyId.String():       keyId,
+				signingkeyfields.SigningKey.String():  key.SigningKey,
+			})
+		}
+	}
+
+	return keys
+}
+
+func getSigningKeys(data *schema.ResourceData) []interface{} {
+
+	if list := getFieldAsList(data, fields.TokenPolicy.String()); len(list) == 1 {
+		if keys, ok := list[0][tokenpolicyfields.SigningKeys.String()].(*schema.Set); ok {
+			return keys.List()
+		}
+	}
+
+	return nil
+}
+
 func mapIdentityZoneInputPromptsToInterface(data []*api.InputPrompt) (prompts []map[string]interface{}) {
 
 	for _, prompt := range data {
@@ -222,12 +282,13 @@ func MapResourceToIdentityZone(data *schema.ResourceData) *api.IdentityZone {
 func mapResourceToIdentityZoneConfig(data *schema.ResourceData) *api.IdentityZoneConfig {
 
 	config := &api.IdentityZoneConfig{
-		AccountChooserEnabled: data.Get(fields.AccountChooserEnabled.String()).(bool),
-		IdpDiscoveryEnabled:   data.Get(fields.IdpDiscoveryEnabled.String()).(bool),
-		InputPrompts:          mapResourceToIdentityZoneInputPrompts(data),
-		IssuerUrl:             data.Get(fields.IssuerUrl.String()).(string),
-		MfaConfig:             mapResourceToIdentityZoneMfaConfig(data),
-		UserConfig:            mapResourceToIdentityZoneUserConfig(data),
+		AccountChooserEnabled:   data.Get(fields.AccountChooserEnabled.String()).(bool),
+		DefaultIdentityProvider: data.Get(fields.DefaultIdentityProvider.String()).(string),
+		IdpDiscoveryEnabled:     data.Get(fields.IdpDiscoveryEnabled.String()).(bool),
+		InputPrompts:            mapResourceToIdentityZoneInputPrompts(data),
+		IssuerUrl:               data.Get(fields.IssuerUrl.String()).(string),
+		MfaConfig:               mapResourceToIdentityZoneMfaConfig(data),
+		UserConfig:              mapResourceToIdentityZoneUserConfig(data),
 	}
 
 	if clientSecretPolicy := mapResourceToIdentityZoneClientSecretPolicy(data); clientSecretPolicy != nil {
@@ -245,6 +306,9 @@ func mapResourceToIdentityZoneConfig(data *schema.ResourceData) *api.IdentityZon
 	if branding := mapResourceToIdentityZoneBrandingConfig(data); branding != nil {
 		config.Branding = branding
 	}
+	if links := mapResourceToIdentityZoneLinks(data); links != nil {
+		config.Links = links
+	}
 
 	return config
 }
@@ -312,6 +376,7 @@ func mapResourceToIdentityZoneMfaConfig(data *schema.ResourceData) *api.MfaConfi
 
 	return &api.MfaConfig{
 		IsEnabled:         data.Get(fields.MfaEnabled.String()).(bool),
+		ProviderName:      data.Get(fields.MfaProviderName.String()).(string),
 		IdentityProviders: providers,
 	}
 }
@@ -335,6 +400,14 @@ func mapResourceToIdentityZoneTokenPolicy(data *schema.ResourceData) *api.Identi
 		tokenPolicy := list[0]
 		accessTokenTtl := int64(tokenPolicy[tokenpolicyfields.AccessTokenTtl.String()].(int))
 		refreshTokenTtl := int64(tokenPolicy[tokenpolicyfields.RefreshTokenTtl.String()].(int))
+
+		keys := mapResourceToIdentityZoneTokenKeys(tokenPolicy[tokenpolicyfields.SigningKeys.String()])
+		signingKeysKey := fmt.Sprintf("%s.0.%s", fields.TokenPolicy.String(), tokenpolicyfields.SigningKeys.String())
+		if keys == nil && data.HasChange(signingKeysKey) {
+			// The last key was removed, which has to be sent explicitly as otherwise UAA keeps the current keys
+			keys = map[string]*api.IdentityZoneTokenKey{}
+		}
+
 		return &api.IdentityZoneTokenPolicy{
 			AccessTokenTtl:       &accessTokenTtl,
 			RefreshTokenTtl:      &refreshTokenTtl,
@@ -342,12 +415,34 @@ func mapResourceToIdentityZoneTokenPolicy(data *schema.ResourceData) *api.Identi
 			IsRefreshTokenUnique: tokenPolicy[tokenpolicyfields.IsRefreshTokenUnique.String()].(bool),
 			RefreshTokenFormat:   tokenPolicy[tokenpolicyfields.RefreshTokenFormat.String()].(string),
 			ActiveKeyId:          tokenPolicy[tokenpolicyfields.ActiveKeyId.String()].(string),
+			Keys:                 keys,
 		}
 	}
 
 	return nil
 }
 
+func mapResourceToIdentityZoneTokenKeys(data interface{}) map[string]*api.IdentityZoneTokenKey {
+
+	set, ok := data.(*schema.Set)
+	if !ok || set.Len() == 0 {
+		return nil
+	}
+
+	keys := make(map[string]*api.IdentityZoneTokenKey, set.Len())
+	for _, k := range set.List() {
+		if key, ok := k.(map[string]interface{}); ok {
+			keys[key[signingkeyfields.KeyId.String()].(string)] = &api.IdentityZoneTokenKey{
+				SigningAlg:  key[signingkeyfields.Algorithm.String()].(string),
+				SigningCert: key[signingkeyfields.Certificate.String()].(string),
+				SigningKey:  key[signingkeyfields.SigningKey.String()].(string),
+			}
+		}
+	}
+
+	return keys
+}
+
 func mapResourceToIdentityZoneSamlConfig(data *schema.ResourceData) *api.IdentityZoneSamlConfig {
 
 	if list := getFieldAsList(data, fields.SamlConfig.String()); len(list) == 1 {
@@ -403,6 +498,15 @@ func mapResourceToIdentityZoneBrandingConfig(data *schema.ResourceData) *api.Ide
 			FooterText:  branding[brandingfields.FooterText.String()].(string),
 		}
 
+		consentText := branding[brandingfields.ConsentText.String()].(string)
+		consentLink := branding[brandingfields.ConsentLink.String()].(string)
+		if consentText != "" || consentLink != "" {
+			brandingConfig.Consent = &api.IdentityZoneBrandingConsent{
+				Text: consentText,
+				Link: consentLink,
+			}
+		}
+
 		if links, ok := branding[brandingfields.FooterLinks.String()].(*schema.Set); ok {
 			brandingConfig.FooterLinks = mapResourceToIdentityZoneBrandingFooterLinks(links)
 		}
@@ -429,15 +533,68 @@ func mapResourceToIdentityZoneBrandingFooterLinks(data *schema.Set) map[string]s
 
 func mapResourceToIdentityZoneUserConfig(data *schema.ResourceData) *api.UserConfig {
 
-	groupsList := data.Get(fields.DefaultUserGroups.String()).(*schema.Set).List()
-	groups := make([]string, len(groupsList))
-	for i, v := range groupsList {
-		groups[i] = v.(string)
+	userConfig := &api.UserConfig{
+		AllowedGroups:        mapSchemaSetSetToStringSlice(data.Get(fields.AllowedUserGroups.String())),
+		CheckCustomerEnabled: data.Get(fields.CheckCustomerEnabled.String()).(bool),
+		CheckOriginEnabled:   data.Get(fields.CheckOriginEnabled.String()).(bool),
+		DefaultGroups:        mapSchemaSetSetToStringSlice(data.Get(fields.DefaultUserGroups.String())),
 	}
 
-	return &api.UserConfig{
-		DefaultGroups: groups,
+	// UAA treats a negative limit as unlimited
+	if maxUsers := int64(data.Get(fields.MaxUsers.String()).(int)); maxUsers != 0 {
+		userConfig.MaxUsers = &maxUsers
 	}
+
+	return userConfig
+}
+
+func mapResourceToIdentityZoneLinks(data *schema.ResourceData) *api.IdentityZoneLinks {
+
+	if !hasConfiguredLinks(data) {
+		return nil
+	}
+
+	return &api.IdentityZoneLinks{
+		HomeRedirect: data.Get(fields.HomeRedirectUrl.String()).(string),
+		Logout: &api.IdentityZoneLogoutLinks{
+			RedirectUrl:              data.Get(fields.LogoutRedirectUrl.String()).(string),
+			RedirectParameterName:    data.Get(fields.LogoutRedirectParam.String()).(string),
+			AllowedRedirectUrls:      mapSchemaSetSetToStringSlice(data.Get(fields.LogoutAllowedRedirectUrls.String())),
+			DisableRedirectParameter: data.Get(fields.LogoutDisableRedirectParam.String()).(bool),
+		},
+		SelfService: &api.SelfServiceLinks{
+			Enabled:          data.Get(fields.SelfServeEnabled.String()).(bool),
+			SignupUrl:        data.Get(fields.SelfServeSignupUrl.String()).(string),
+			PasswordResetUrl: data.Get(fields.SelfServePasswordResetUrl.String()).(string),
+		},
+	}
+}
+
+var linkFields = []fields.IdentityZoneField{
+	fields.HomeRedirectUrl,
+	fields.LogoutAllowedRedirectUrls,
+	fields.LogoutDisableRedirectParam,
+	fields.LogoutRedirectParam,
+	fields.LogoutRedirectUrl,
+	fields.SelfServeEnabled,
+	fields.SelfServePasswordResetUrl,
+	fields.SelfServeSignupUrl,
+}
+
+// hasConfiguredLinks reports whether any of the zone's links are set in the configuration, or were changed by removing
+// them from it.  When none are, the links are left as they are in UAA rather than reset to the schema's defaults.
+func hasConfiguredLinks(data *schema.ResourceData) bool {
+
+	config := data.GetRawConfig()
+	for _, field := range linkFields {
+		if !config.IsNull() && config.IsKnown() && !config.GetAttr(field.String()).IsNull() {
+			return true
+		}
+		if data.Id() != "" && data.HasChange(field.String()) {
+			return true
+		}
+	}
+	return false
 }
 
 func getFieldAsList(data *schema.ResourceData, field string) []map[string]interface{} {
@@ -467,3 +624,17 @@ func mapSchemaSetSetToStringSlice(i interface{}) []string {
 
 	return items
 }
+
+func MapIdentityZonesToList(identityZones []api.IdentityZone) []map[string]interface{} {
+
+	list := []map[string]interface{}{}
+	for _, identityZone := range identityZones {
+		list = append(list, map[string]interface{}{
+			fields.Id.String():        identityZone.Id,
+			fields.Name.String():      identityZone.Name,
+			fields.SubDomain.String(): identityZone.SubDomain,
+			fields.IsActive.String():  identityZone.IsActive,
+		})
+	}
+	return list
+}
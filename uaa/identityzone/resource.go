@@ -2,8 +2,13 @@ package identityzone
 
 import (
 	"context"
+	"fmt"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/signingkeyfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/tokenpolicyfields"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,6 +18,10 @@ var Resource = &schema.Resource{
 	ReadContext:   readResource,
 	UpdateContext: updateResource,
 	DeleteContext: deleteResource,
+	CustomizeDiff: customdiff.All(validateTokenPolicy, validateMfaConfig),
+	Importer: &schema.ResourceImporter{
+		StateContext: schema.ImportStatePassthroughContext,
+	},
 }
 
 func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -25,6 +34,20 @@ func createResource(ctx context.Context, data *schema.ResourceData, i interface{
 	izm := session.IdentityZoneManager()
 
 	identityZone := MapResourceToIdentityZone(data)
+
+	// MFA providers belong to a zone, so the provider a new zone references can only be added to it once the zone has
+	// been created.  The zone is created without MFA, which the next apply enables.
+	var diags diag.Diagnostics
+	if mfaConfig := identityZone.Config.MfaConfig; mfaConfig.ProviderName != "" {
+		identityZone.Config.MfaConfig = &api.MfaConfig{IdentityProviders: mfaConfig.IdentityProviders}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "MFA isn't enabled yet",
+			Detail: fmt.Sprintf("The identity zone was created without MFA, as the MFA provider '%s' can only be added "+
+				"to it once it exists.  Apply again once the provider has been added to enable MFA.", mfaConfig.ProviderName),
+		})
+	}
+
 	response, err := izm.Create(identityZone)
 	if err != nil {
 		return diag.FromErr(err)
@@ -32,7 +55,7 @@ func createResource(ctx context.Context, data *schema.ResourceData, i interface{
 
 	MapIdentityZoneToResource(response, data)
 
-	return nil
+	return diags
 }
 
 func readResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -45,8 +68,12 @@ func readResource(ctx context.Context, data *schema.ResourceData, i interface{})
 	izm := session.IdentityZoneManager()
 
 	response, err := izm.FindById(data.Id())
-
 	if err != nil {
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("Identity zone with Id '%s' no longer exists; removing it from state", data.Id())
+			data.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -65,6 +92,7 @@ func updateResource(ctx context.Context, data *schema.ResourceData, i interface{
 	izm := session.IdentityZoneManager()
 
 	identityZone := MapResourceToIdentityZone(data)
+
 	response, err := izm.Update(data.Id(), identityZone)
 	if err != nil {
 		return diag.FromErr(err)
@@ -84,9 +112,78 @@ func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{
 
 	izm := session.IdentityZoneManager()
 
-	if err := izm.Delete(data.Id()); err != nil {
+	if err := izm.Delete(data.Id()); err != nil && !api.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
+
+// validateTokenPolicy makes sure the active key is one of the zone's signing keys.  Keys should be rotated by adding
+// the new key, switching the active key to it and then removing the old key in separate applies, so that tokens signed
+// with the old key can still be verified in between.
+func validateTokenPolicy(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+
+	list := diff.Get(fields.TokenPolicy.String()).([]interface{})
+	if len(list) != 1 || list[0] == nil {
+		return nil
+	}
+	tokenPolicy := list[0].(map[string]interface{})
+
+	// Keys and key IDs that are only known once applied can't be checked yet
+	activeKeyIdKey := fmt.Sprintf("%s.0.%s", fields.TokenPolicy.String(), tokenpolicyfields.ActiveKeyId.String())
+	signingKeysKey := fmt.Sprintf("%s.0.%s", fields.TokenPolicy.String(), tokenpolicyfields.SigningKeys.String())
+	if !diff.NewValueKnown(activeKeyIdKey) || !diff.NewValueKnown(signingKeysKey) {
+		return nil
+	}
+
+	activeKeyId := tokenPolicy[tokenpolicyfields.ActiveKeyId.String()].(string)
+	signingKeys := tokenPolicy[tokenpolicyfields.SigningKeys.String()].(*schema.Set)
+	if activeKeyId == "" || signingKeys.Len() == 0 {
+		return nil
+	}
+
+	for _, k := range signingKeys.List() {
+		if key, ok := k.(map[string]interface{}); ok && key[signingkeyfields.KeyId.String()] == activeKeyId {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the token policy's active_key_id '%s' must match the key_id of one of its signing_keys", activeKeyId)
+}
+
+// validateMfaConfig makes sure that MFA is only enabled with an MFA provider, and that the provider exists when an
+// existing zone starts referencing it.  A new zone can't have any providers yet, so it's created without MFA instead.
+func validateMfaConfig(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+
+	if !diff.NewValueKnown(fields.MfaEnabled.String()) || !diff.NewValueKnown(fields.MfaProviderName.String()) {
+		return nil
+	}
+
+	name := diff.Get(fields.MfaProviderName.String()).(string)
+	if name == "" {
+		if diff.Get(fields.MfaEnabled.String()).(bool) {
+			return fmt.Errorf("mfa_provider_name must be set when mfa_enabled is true")
+		}
+		return nil
+	}
+
+	zoneId := diff.Id()
+	if zoneId == "" || !diff.HasChange(fields.MfaProviderName.String()) {
+		return nil
+	}
+
+	session := i.(*api.Session)
+	if session == nil {
+		return fmt.Errorf("client is nil")
+	}
+
+	if _, err := session.MfaProviderManager().FindByName(name, zoneId); err != nil {
+		if api.IsNotFound(err) {
+			return fmt.Errorf("the MFA provider '%s' doesn't exist in identity zone '%s'", name, zoneId)
+		}
+		return err
+	}
+
+	return nil
+}
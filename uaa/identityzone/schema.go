@@ -10,6 +10,7 @@ import (
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/inputpromptfields"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/samlconfigfields"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/samlkeyfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/signingkeyfields"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone/tokenpolicyfields"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +22,27 @@ var identityZoneSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 	},
+	fields.AllowedUserGroups.String(): {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	fields.CheckCustomerEnabled.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	fields.CheckOriginEnabled.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	fields.DefaultIdentityProvider.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
 	fields.DefaultUserGroups.String(): {
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -60,6 +82,11 @@ var identityZoneSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 	},
+	fields.LogoutDisableRedirectParam.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
 	fields.LogoutRedirectUrl.String(): {
 		Type:     schema.TypeString,
 		Optional: true,
@@ -73,6 +100,12 @@ var identityZoneSchema = map[string]*schema.Schema{
 			Type: schema.TypeString,
 		},
 	},
+	fields.MaxUsers.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		ValidateFunc: validation.IntAtLeast(-1),
+	},
 	fields.MfaEnabled.String(): {
 		Type:     schema.TypeBool,
 		Optional: true,
@@ -86,6 +119,10 @@ var identityZoneSchema = map[string]*schema.Schema{
 			Type: schema.TypeString,
 		},
 	},
+	fields.MfaProviderName.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
 	fields.Name.String(): {
 		Type:     schema.TypeString,
 		Required: true,
@@ -189,6 +226,15 @@ var BrandingSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 	},
+	brandingfields.ConsentLink.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+	},
+	brandingfields.ConsentText.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
 	brandingfields.Favicon.String(): {
 		Type:     schema.TypeString,
 		Optional: true,
@@ -272,6 +318,35 @@ var TokenPolicySchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
 	},
+	tokenpolicyfields.SigningKeys.String(): {
+		Type:      schema.TypeSet,
+		Optional:  true,
+		Sensitive: true,
+		Elem: &schema.Resource{
+			Schema: SigningKeySchema,
+		},
+	},
+}
+
+var SigningKeySchema = map[string]*schema.Schema{
+	signingkeyfields.Algorithm.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"RS256", "RS384", "RS512", "HS256", "HS384", "HS512"}, false),
+	},
+	signingkeyfields.Certificate.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	signingkeyfields.KeyId.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	signingkeyfields.SigningKey.String(): {
+		Type:      schema.TypeString,
+		Required:  true,
+		Sensitive: true,
+	},
 }
 
 var SamlConfigSchema = map[string]*schema.Schema{
@@ -420,12 +495,13 @@ func mapSchemaForDataSource(originalSchema map[string]*schema.Schema) map[string
 	for k, v := range originalSchema {
 		isName := k == fields.Name.String()
 		dsSchema[k] = &schema.Schema{
-			Type:     v.Type,
-			Required: isName,
-			Computed: !isName,
-			Elem:     v.Elem,
+			Type:      v.Type,
+			Required:  isName,
+			Computed:  !isName,
+			Sensitive: v.Sensitive,
+			Elem:      v.Elem,
 		}
-		if v.Type == schema.TypeList {
+		if v.Type == schema.TypeList || v.Type == schema.TypeSet {
 			if elem, ok := v.Elem.(*schema.Resource); ok {
 				dsSchema[k].Elem = &schema.Resource{
 					Schema: mapSchemaForDataSource(elem.Schema),
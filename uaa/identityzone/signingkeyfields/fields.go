@@ -0,0 +1,24 @@
+package signingkeyfields
+
+type SigningKeyField int64
+
+const (
+	Algorithm SigningKeyField = iota
+	Certificate
+	KeyId
+	SigningKey
+)
+
+func (s SigningKeyField) String() string {
+	switch s {
+	case Algorithm:
+		return "algorithm"
+	case Certificate:
+		return "certificate"
+	case KeyId:
+		return "key_id"
+	case SigningKey:
+		return "signing_key"
+	}
+	return "unknown"
+}
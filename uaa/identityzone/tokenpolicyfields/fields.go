@@ -9,6 +9,7 @@ const (
 	IsRefreshTokenUnique
 	RefreshTokenFormat
 	RefreshTokenTtl
+	SigningKeys
 )
 
 func (s TokenPolicyField) String() string {
@@ -25,6 +26,8 @@ func (s TokenPolicyField) String() string {
 		return "refresh_token_format"
 	case RefreshTokenTtl:
 		return "refresh_token_ttl"
+	case SigningKeys:
+		return "signing_keys"
 	}
 	return "unknown"
 }
@@ -0,0 +1,24 @@
+package fields
+
+type ListOptionsField int64
+
+const (
+	Attributes ListOptionsField = iota
+	Filter
+	SortBy
+	SortOrder
+)
+
+func (s ListOptionsField) String() string {
+	switch s {
+	case Attributes:
+		return "attributes"
+	case Filter:
+		return "filter"
+	case SortBy:
+		return "sort_by"
+	case SortOrder:
+		return "sort_order"
+	}
+	return "unknown"
+}
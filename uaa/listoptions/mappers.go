@@ -0,0 +1,37 @@
+package listoptions
+
+import (
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/listoptions/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+)
+
+func MapResourceToListOptions(data *schema.ResourceData) api.ListOptions {
+
+	options := api.ListOptions{
+		Filter:    data.Get(fields.Filter.String()).(string),
+		SortBy:    data.Get(fields.SortBy.String()).(string),
+		SortOrder: data.Get(fields.SortOrder.String()).(string),
+	}
+	if attributes, ok := data.GetOk(fields.Attributes.String()); ok {
+		options.Attributes = util.ToStringsSlice(attributes)
+	}
+	return options
+}
+
+// Id identifies the results of listing resources with the given options.
+func Id(zoneId string, options api.ListOptions) string {
+
+	key := strings.Join([]string{
+		zoneId,
+		options.Filter,
+		options.SortBy,
+		options.SortOrder,
+		strings.Join(options.Attributes, ","),
+	}, "\n")
+
+	return fmt.Sprintf("%d", util.ResourceStringHash(key))
+}
@@ -0,0 +1,42 @@
+package listoptions
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/listoptions/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Schema returns the arguments shared by the data sources that list resources through SCIM endpoints, merged with the
+// data source's own schema.
+func Schema(dataSourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+
+	s := map[string]*schema.Schema{
+		fields.Filter.String(): {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		fields.SortBy.String(): {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		fields.SortOrder.String(): {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      api.SortOrderAscending,
+			ValidateFunc: validation.StringInSlice([]string{api.SortOrderAscending, api.SortOrderDescending}, false),
+		},
+		fields.Attributes.String(): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      util.ResourceStringHash,
+		},
+	}
+
+	for k, v := range dataSourceSchema {
+		s[k] = v
+	}
+	return s
+}
@@ -0,0 +1,33 @@
+package fields
+
+type MfaProviderField int64
+
+const (
+	Algorithm MfaProviderField = iota
+	Digits
+	Duration
+	Issuer
+	Name
+	Type
+	ZoneId
+)
+
+func (s MfaProviderField) String() string {
+	switch s {
+	case Algorithm:
+		return "algorithm"
+	case Digits:
+		return "digits"
+	case Duration:
+		return "duration"
+	case Issuer:
+		return "issuer"
+	case Name:
+		return "name"
+	case Type:
+		return "type"
+	case ZoneId:
+		return "zone_id"
+	}
+	return "unknown"
+}
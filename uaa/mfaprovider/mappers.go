@@ -0,0 +1,40 @@
+package mfaprovider
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/mfaprovider/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Mapper methods for mapping API objects to TF resources
+
+func MapMfaProviderToResource(mfaProvider *api.MfaProvider, data *schema.ResourceData) {
+
+	data.SetId(mfaProvider.Id)
+	data.Set(fields.Name.String(), mfaProvider.Name)
+	data.Set(fields.Type.String(), mfaProvider.Type)
+	data.Set(fields.ZoneId.String(), mfaProvider.IdentityZoneId)
+
+	if config := mfaProvider.Config; config != nil {
+		data.Set(fields.Algorithm.String(), config.Algorithm)
+		data.Set(fields.Digits.String(), config.Digits)
+		data.Set(fields.Duration.String(), config.Duration)
+		data.Set(fields.Issuer.String(), config.Issuer)
+	}
+}
+
+// Mapper methods for mapping TF resources to API objects
+
+func MapResourceToMfaProvider(data *schema.ResourceData) *api.MfaProvider {
+
+	return &api.MfaProvider{
+		Name: data.Get(fields.Name.String()).(string),
+		Type: data.Get(fields.Type.String()).(string),
+		Config: &api.MfaProviderConfig{
+			Algorithm: data.Get(fields.Algorithm.String()).(string),
+			Digits:    data.Get(fields.Digits.String()).(int),
+			Duration:  data.Get(fields.Duration.String()).(int),
+			Issuer:    data.Get(fields.Issuer.String()).(string),
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package mfaprovider
+
+import (
+	"context"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/mfaprovider/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var Resource = &schema.Resource{
+	Schema:        mfaProviderSchema,
+	CreateContext: createResource,
+	ReadContext:   readResource,
+	DeleteContext: deleteResource,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
+}
+
+func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	mpm := session.MfaProviderManager()
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	response, err := mpm.Create(MapResourceToMfaProvider(data), zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("New MFA provider created: %# v", response)
+
+	MapMfaProviderToResource(response, data)
+
+	return nil
+}
+
+func readResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	mpm := session.MfaProviderManager()
+	id := data.Id()
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	response, err := mpm.FindById(id, zoneId)
+	if err != nil {
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("MFA provider with GUID '%s' no longer exists; removing it from state", id)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("MFA provider with GUID '%s' retrieved: %# v", id, response)
+
+	MapMfaProviderToResource(response, data)
+
+	return nil
+}
+
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId, name, err := util.ParseImportId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	mfaProvider, err := session.MfaProviderManager().FindByName(name, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(mfaProvider.Id)
+	data.Set(fields.ZoneId.String(), mfaProvider.IdentityZoneId)
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	mpm := session.MfaProviderManager()
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	if err := mpm.Delete(data.Id(), zoneId); err != nil && !api.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
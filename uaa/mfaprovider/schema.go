@@ -0,0 +1,61 @@
+package mfaprovider
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/mfaprovider/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// UAA only supports TOTP authenticator apps such as Google Authenticator
+const googleAuthenticator = "google-authenticator"
+
+var algorithms = []string{"SHA256", "SHA512"}
+
+// UAA can't update MFA providers, so any change replaces the provider
+var mfaProviderSchema = map[string]*schema.Schema{
+	fields.Algorithm.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "SHA256",
+		ValidateFunc: validation.StringInSlice(algorithms, false),
+	},
+	fields.Digits.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      6,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	fields.Duration.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      30,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	fields.Issuer.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+	fields.Name.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	fields.Type.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      googleAuthenticator,
+		ValidateFunc: validation.StringInSlice([]string{googleAuthenticator}, false),
+	},
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		ForceNew: true,
+		Optional: true,
+		Computed: true,
+	},
+}
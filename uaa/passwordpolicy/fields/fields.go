@@ -0,0 +1,21 @@
+package fields
+
+type PasswordPolicyField int64
+
+const (
+	LockoutPolicy PasswordPolicyField = iota
+	PasswordPolicy
+	ZoneId
+)
+
+func (s PasswordPolicyField) String() string {
+	switch s {
+	case LockoutPolicy:
+		return "lockout_policy"
+	case PasswordPolicy:
+		return "password_policy"
+	case ZoneId:
+		return "zone_id"
+	}
+	return "unknown"
+}
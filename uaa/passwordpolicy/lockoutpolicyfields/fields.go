@@ -0,0 +1,21 @@
+package lockoutpolicyfields
+
+type LockoutPolicyField int64
+
+const (
+	CountFailuresWithinSeconds LockoutPolicyField = iota
+	LockoutAfterFailures
+	LockoutPeriodSeconds
+)
+
+func (s LockoutPolicyField) String() string {
+	switch s {
+	case CountFailuresWithinSeconds:
+		return "count_failures_within_seconds"
+	case LockoutAfterFailures:
+		return "lockout_after_failures"
+	case LockoutPeriodSeconds:
+		return "lockout_period_seconds"
+	}
+	return "unknown"
+}
@@ -0,0 +1,71 @@
+package passwordpolicy
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/passwordpolicy/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/passwordpolicy/lockoutpolicyfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/passwordpolicy/passwordpolicyfields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func MapResourceToPolicies(data *schema.ResourceData) api.UaaProviderPolicies {
+
+	policies := api.UaaProviderPolicies{}
+
+	if rules := getBlock(data, fields.PasswordPolicy.String()); rules != nil {
+		policies.PasswordPolicy = &api.PasswordPolicy{
+			MinLength:                 rules[passwordpolicyfields.MinLength.String()].(int),
+			MaxLength:                 rules[passwordpolicyfields.MaxLength.String()].(int),
+			RequireUpperCaseCharacter: rules[passwordpolicyfields.RequireUpperCaseCharacter.String()].(int),
+			RequireLowerCaseCharacter: rules[passwordpolicyfields.RequireLowerCaseCharacter.String()].(int),
+			RequireDigit:              rules[passwordpolicyfields.RequireDigit.String()].(int),
+			RequireSpecialCharacter:   rules[passwordpolicyfields.RequireSpecialCharacter.String()].(int),
+			ExpirePasswordInMonths:    rules[passwordpolicyfields.ExpirePasswordInMonths.String()].(int),
+		}
+	}
+
+	if rules := getBlock(data, fields.LockoutPolicy.String()); rules != nil {
+		policies.LockoutPolicy = &api.LockoutPolicy{
+			LockoutAfterFailures: rules[lockoutpolicyfields.LockoutAfterFailures.String()].(int),
+			CountFailuresWithin:  rules[lockoutpolicyfields.CountFailuresWithinSeconds.String()].(int),
+			LockoutPeriodSeconds: rules[lockoutpolicyfields.LockoutPeriodSeconds.String()].(int),
+		}
+	}
+
+	return policies
+}
+
+func MapPoliciesToResource(policies *api.UaaProviderPolicies, data *schema.ResourceData) {
+
+	var passwordPolicy []map[string]interface{}
+	if p := policies.PasswordPolicy; p != nil {
+		passwordPolicy = []map[string]interface{}{{
+			passwordpolicyfields.MinLength.String():                 p.MinLength,
+			passwordpolicyfields.MaxLength.String():                 p.MaxLength,
+			passwordpolicyfields.RequireUpperCaseCharacter.String(): p.RequireUpperCaseCharacter,
+			passwordpolicyfields.RequireLowerCaseCharacter.String(): p.RequireLowerCaseCharacter,
+			passwordpolicyfields.RequireDigit.String():              p.RequireDigit,
+			passwordpolicyfields.RequireSpecialCharacter.String():   p.RequireSpecialCharacter,
+			passwordpolicyfields.ExpirePasswordInMonths.String():    p.ExpirePasswordInMonths,
+		}}
+	}
+	data.Set(fields.PasswordPolicy.String(), passwordPolicy)
+
+	var lockoutPolicy []map[string]interface{}
+	if p := policies.LockoutPolicy; p != nil {
+		lockoutPolicy = []map[string]interface{}{{
+			lockoutpolicyfields.LockoutAfterFailures.String():       p.LockoutAfterFailures,
+			lockoutpolicyfields.CountFailuresWithinSeconds.String(): p.CountFailuresWithin,
+			lockoutpolicyfields.LockoutPeriodSeconds.String():       p.LockoutPeriodSeconds,
+		}}
+	}
+	data.Set(fields.LockoutPolicy.String(), lockoutPolicy)
+}
+
+func getBlock(data *schema.ResourceData, name string) map[string]interface{} {
+
+	if list := data.Get(name).([]interface{}); len(list) == 1 && list[0] != nil {
+		return list[0].(map[string]interface{})
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package passwordpolicyfields
+
+type PasswordPolicyField int64
+
+const (
+	ExpirePasswordInMonths PasswordPolicyField = iota
+	MaxLength
+	MinLength
+	RequireDigit
+	RequireLowerCaseCharacter
+	RequireSpecialCharacter
+	RequireUpperCaseCharacter
+)
+
+func (s PasswordPolicyField) String() string {
+	switch s {
+	case ExpirePasswordInMonths:
+		return "expire_password_in_months"
+	case MaxLength:
+		return "max_length"
+	case MinLength:
+		return "min_length"
+	case RequireDigit:
+		return "require_digit"
+	case RequireLowerCaseCharacter:
+		return "require_lower_case_character"
+	case RequireSpecialCharacter:
+		return "require_special_character"
+	case RequireUpperCaseCharacter:
+		return "require_upper_case_character"
+	}
+	return "unknown"
+}
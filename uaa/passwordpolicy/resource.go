@@ -0,0 +1,174 @@
+package passwordpolicy
+
+import (
+	"context"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/passwordpolicy/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resource manages the password and lockout policies of an identity zone.  UAA keeps these on the zone's internal `uaa`
+// identity provider, which always exists, so the resource is identified by the zone.
+var Resource = &schema.Resource{
+	Schema:        passwordPolicySchema,
+	CreateContext: createResource,
+	ReadContext:   readResource,
+	UpdateContext: updateResource,
+	DeleteContext: deleteResource,
+	CustomizeDiff: validatePasswordRules,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
+}
+
+func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	policies, err := applyPolicies(session, data, zoneId, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Password policy for zone '%s' set: %# v", zoneId, policies)
+
+	data.SetId(zoneId)
+	data.Set(fields.ZoneId.String(), zoneId)
+	MapPoliciesToResource(managedPolicies(policies, data), data)
+
+	return nil
+}
+
+func readResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	zoneId := data.Id()
+
+	policies, err := session.IdentityProviderManager().GetUaaPolicies(zoneId)
+	if err != nil {
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("Zone '%s' no longer exists; removing its password policy from state", zoneId)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Password policy for zone '%s' retrieved: %# v", zoneId, policies)
+
+	data.Set(fields.ZoneId.String(), zoneId)
+	MapPoliciesToResource(managedPolicies(policies, data), data)
+
+	return nil
+}
+
+func updateResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	zoneId := data.Id()
+
+	policies, err := applyPolicies(session, data, zoneId, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Password policy for zone '%s' updated: %# v", zoneId, policies)
+
+	MapPoliciesToResource(managedPolicies(policies, data), data)
+
+	return nil
+}
+
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId := session.ResolveZoneId(data.Id())
+
+	// Both policies are imported when the zone has them; a block that is then left out of the config is reset
+	policies, err := session.IdentityProviderManager().GetUaaPolicies(zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(zoneId)
+	data.Set(fields.ZoneId.String(), zoneId)
+	MapPoliciesToResource(policies, data)
+
+	return []*schema.ResourceData{data}, nil
+}
+
+// deleteResource removes the managed policies from the zone, which then falls back to UAA's defaults.
+func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	if _, err := applyPolicies(session, data, data.Id(), true); err != nil && !api.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// applyPolicies sets the configured policies on the zone.  The zone's current policy is kept for a block that isn't
+// managed by terraform, and a block that is removed from the config, or the whole resource when deleting, is reset
+// to UAA's defaults.
+func applyPolicies(session *api.Session, data *schema.ResourceData, zoneId string, deleting bool) (*api.UaaProviderPolicies, error) {
+
+	ipm := session.IdentityProviderManager()
+
+	current, err := ipm.GetUaaPolicies(zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := MapResourceToPolicies(data)
+	if deleting {
+		policies = api.UaaProviderPolicies{}
+	}
+	if !isManaged(data, fields.PasswordPolicy.String()) {
+		policies.PasswordPolicy = current.PasswordPolicy
+	}
+	if !isManaged(data, fields.LockoutPolicy.String()) {
+		policies.LockoutPolicy = current.LockoutPolicy
+	}
+
+	return ipm.UpdateUaaPolicies(policies, zoneId)
+}
+
+// managedPolicies leaves out the policies that terraform doesn't manage, so that they don't show up as drift.
+func managedPolicies(policies *api.UaaProviderPolicies, data *schema.ResourceData) *api.UaaProviderPolicies {
+
+	managed := *policies
+	if !isManaged(data, fields.PasswordPolicy.String()) {
+		managed.PasswordPolicy = nil
+	}
+	if !isManaged(data, fields.LockoutPolicy.String()) {
+		managed.LockoutPolicy = nil
+	}
+	return &managed
+}
+
+// isManaged reports whether the policy block is in the config, or was until this change.
+func isManaged(data *schema.ResourceData, block string) bool {
+	o, n := data.GetChange(block)
+	return len(o.([]interface{})) > 0 || len(n.([]interface{})) > 0
+}
@@ -0,0 +1,146 @@
+package passwordpolicy
+
+import (
+	"context"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/passwordpolicy/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/passwordpolicy/lockoutpolicyfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/passwordpolicy/passwordpolicyfields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// UAA rejects passwords longer than this
+const maxPasswordLength = 255
+
+var policyBlocks = []string{
+	fields.LockoutPolicy.String(),
+	fields.PasswordPolicy.String(),
+}
+
+var passwordPolicySchema = map[string]*schema.Schema{
+	fields.PasswordPolicy.String(): {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		AtLeastOneOf: policyBlocks,
+		Elem: &schema.Resource{
+			Schema: PasswordRulesSchema,
+		},
+	},
+	fields.LockoutPolicy.String(): {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		AtLeastOneOf: policyBlocks,
+		Elem: &schema.Resource{
+			Schema: LockoutRulesSchema,
+		},
+	},
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		ForceNew: true,
+		Optional: true,
+		Computed: true,
+	},
+}
+
+var PasswordRulesSchema = map[string]*schema.Schema{
+	passwordpolicyfields.MinLength.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntBetween(0, maxPasswordLength),
+	},
+	passwordpolicyfields.MaxLength.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      maxPasswordLength,
+		ValidateFunc: validation.IntBetween(1, maxPasswordLength),
+	},
+	passwordpolicyfields.RequireUpperCaseCharacter.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	passwordpolicyfields.RequireLowerCaseCharacter.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	passwordpolicyfields.RequireDigit.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	passwordpolicyfields.RequireSpecialCharacter.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	passwordpolicyfields.ExpirePasswordInMonths.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+}
+
+var LockoutRulesSchema = map[string]*schema.Schema{
+	lockoutpolicyfields.LockoutAfterFailures.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      5,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	lockoutpolicyfields.CountFailuresWithinSeconds.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1200,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	lockoutpolicyfields.LockoutPeriodSeconds.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      300,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+}
+
+// validatePasswordRules checks that a password can satisfy all the rules at once, which UAA doesn't check until a
+// user tries to set one.
+func validatePasswordRules(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+
+	list := diff.Get(fields.PasswordPolicy.String()).([]interface{})
+	if len(list) != 1 || list[0] == nil {
+		return nil
+	}
+	rules := list[0].(map[string]interface{})
+
+	minLength := rules[passwordpolicyfields.MinLength.String()].(int)
+	maxLength := rules[passwordpolicyfields.MaxLength.String()].(int)
+	if minLength > maxLength {
+		return fmt.Errorf("%s (%d) must not be greater than %s (%d)",
+			passwordpolicyfields.MinLength, minLength, passwordpolicyfields.MaxLength, maxLength)
+	}
+
+	required := 0
+	for _, f := range []passwordpolicyfields.PasswordPolicyField{
+		passwordpolicyfields.RequireUpperCaseCharacter,
+		passwordpolicyfields.RequireLowerCaseCharacter,
+		passwordpolicyfields.RequireDigit,
+		passwordpolicyfields.RequireSpecialCharacter,
+	} {
+		required += rules[f.String()].(int)
+	}
+	if required > maxLength {
+		return fmt.Errorf("the required characters (%d) don't fit in %s (%d)",
+			required, passwordpolicyfields.MaxLength, maxLength)
+	}
+
+	return nil
+}
@@ -3,16 +3,29 @@ package fields
 type ProviderField int64
 
 const (
-	AuthEndpoint ProviderField = iota
+	AccessToken ProviderField = iota
+	AuthEndpoint
 	CaCert
 	ClientId
 	ClientSecret
+	ForceOverwrite
+	JwtAssertion
 	LoginEndpoint
+	PageSize
+	Password
+	RetryBaseBackoff
+	RetryMaxAttempts
+	RetryMaxBackoff
+	RetryStatusCodes
 	SkipSslValidation
+	Username
+	ZoneSubdomain
 )
 
 func (s ProviderField) String() string {
 	switch s {
+	case AccessToken:
+		return "access_token"
 	case AuthEndpoint:
 		return "auth_endpoint"
 	case CaCert:
@@ -21,10 +34,30 @@ func (s ProviderField) String() string {
 		return "client_id"
 	case ClientSecret:
 		return "client_secret"
+	case ForceOverwrite:
+		return "force_overwrite"
+	case JwtAssertion:
+		return "jwt_assertion"
 	case LoginEndpoint:
 		return "login_endpoint"
+	case PageSize:
+		return "page_size"
+	case Password:
+		return "password"
+	case RetryBaseBackoff:
+		return "retry_base_backoff"
+	case RetryMaxAttempts:
+		return "retry_max_attempts"
+	case RetryMaxBackoff:
+		return "retry_max_backoff"
+	case RetryStatusCodes:
+		return "retry_status_codes"
 	case SkipSslValidation:
 		return "skip_ssl_validation"
+	case Username:
+		return "username"
+	case ZoneSubdomain:
+		return "zone_subdomain"
 	}
 	return "unknown"
 }
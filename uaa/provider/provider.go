@@ -5,11 +5,19 @@ import (
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/client"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/group"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmapping"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/groupmembership"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityprovider"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/identityzone"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/mfaprovider"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/passwordpolicy"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/provider/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/samlserviceprovider"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/tokenkeys"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/user"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
 )
 
 func Provider() *schema.Provider {
@@ -23,27 +31,52 @@ func Provider() *schema.Provider {
 }
 
 var DataSources = map[string]*schema.Resource{
-	"uaa_client":        client.DataSource,
-	"uaa_group":         group.DataSource,
-	"uaa_identity_zone": identityzone.DataSource,
-	"uaa_user":          user.DataSource,
+	"uaa_client":            client.DataSource,
+	"uaa_clients":           client.ListDataSource,
+	"uaa_group":             group.DataSource,
+	"uaa_groups":            group.ListDataSource,
+	"uaa_identity_provider": identityprovider.DataSource,
+	"uaa_identity_zone":     identityzone.DataSource,
+	"uaa_identity_zones":    identityzone.ListDataSource,
+	"uaa_token_keys":        tokenkeys.DataSource,
+	"uaa_user":              user.DataSource,
+	"uaa_users":             user.ListDataSource,
 }
 
 var Resources = map[string]*schema.Resource{
-	"uaa_client":        client.Resource,
-	"uaa_group":         group.Resource,
-	"uaa_identity_zone": identityzone.Resource,
-	"uaa_user":          user.Resource,
+	"uaa_client":                client.Resource,
+	"uaa_group":                 group.Resource,
+	"uaa_group_mapping":         groupmapping.Resource,
+	"uaa_group_membership":      groupmembership.Resource,
+	"uaa_identity_provider":     identityprovider.Resource,
+	"uaa_identity_zone":         identityzone.Resource,
+	"uaa_mfa_provider":          mfaprovider.Resource,
+	"uaa_password_policy":       passwordpolicy.Resource,
+	"uaa_saml_service_provider": samlserviceprovider.Resource,
+	"uaa_user":                  user.Resource,
 }
 
 func configureContext(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	retryPolicy, err := mapRetryPolicy(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	config := api.Config{
 		LoginEndpoint:     d.Get(fields.LoginEndpoint.String()).(string),
 		AuthEndpoint:      d.Get(fields.AuthEndpoint.String()).(string),
 		ClientID:          d.Get(fields.ClientId.String()).(string),
 		ClientSecret:      d.Get(fields.ClientSecret.String()).(string),
+		Username:          d.Get(fields.Username.String()).(string),
+		Password:          d.Get(fields.Password.String()).(string),
+		AccessToken:       d.Get(fields.AccessToken.String()).(string),
+		JwtAssertion:      d.Get(fields.JwtAssertion.String()).(string),
 		CaCert:            d.Get(fields.CaCert.String()).(string),
 		SkipSslValidation: d.Get(fields.SkipSslValidation.String()).(bool),
+		PageSize:          d.Get(fields.PageSize.String()).(int),
+		ForceOverwrite:    d.Get(fields.ForceOverwrite.String()).(bool),
+		RetryPolicy:       retryPolicy,
+		ZoneSubdomain:     d.Get(fields.ZoneSubdomain.String()).(string),
 	}
 	client, err := config.Client()
 	if err != nil {
@@ -51,3 +84,26 @@ func configureContext(ctx context.Context, d *schema.ResourceData) (interface{},
 	}
 	return client, nil
 }
+
+func mapRetryPolicy(d *schema.ResourceData) (*api.RetryPolicy, error) {
+
+	retryPolicy := api.DefaultRetryPolicy()
+	retryPolicy.MaxAttempts = d.Get(fields.RetryMaxAttempts.String()).(int)
+
+	var err error
+	if retryPolicy.BaseBackoff, err = time.ParseDuration(d.Get(fields.RetryBaseBackoff.String()).(string)); err != nil {
+		return nil, err
+	}
+	if retryPolicy.MaxBackoff, err = time.ParseDuration(d.Get(fields.RetryMaxBackoff.String()).(string)); err != nil {
+		return nil, err
+	}
+
+	if statusCodes := d.Get(fields.RetryStatusCodes.String()).(*schema.Set); statusCodes.Len() > 0 {
+		retryPolicy.StatusCodes = nil
+		for _, code := range statusCodes.List() {
+			retryPolicy.StatusCodes = append(retryPolicy.StatusCodes, code.(int))
+		}
+	}
+
+	return retryPolicy, nil
+}
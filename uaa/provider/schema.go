@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/envvars"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/provider/fields"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
 )
 
 var Schema = map[string]*schema.Schema{
@@ -27,14 +31,96 @@ var Schema = map[string]*schema.Schema{
 		Optional:    true,
 		DefaultFunc: schema.EnvDefaultFunc(envvars.UaaClientSecret.String(), ""),
 	},
+	fields.Username.String(): {
+		Type:          schema.TypeString,
+		Optional:      true,
+		DefaultFunc:   schema.EnvDefaultFunc(envvars.UaaUsername.String(), ""),
+		ConflictsWith: []string{fields.AccessToken.String(), fields.JwtAssertion.String()},
+		RequiredWith:  []string{fields.Password.String()},
+	},
+	fields.Password.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		DefaultFunc:  schema.EnvDefaultFunc(envvars.UaaPassword.String(), ""),
+		RequiredWith: []string{fields.Username.String()},
+	},
+	fields.AccessToken.String(): {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		DefaultFunc:   schema.EnvDefaultFunc(envvars.UaaAccessToken.String(), ""),
+		ConflictsWith: []string{fields.Username.String(), fields.JwtAssertion.String()},
+	},
+	fields.JwtAssertion.String(): {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		DefaultFunc:   schema.EnvDefaultFunc(envvars.UaaJwtAssertion.String(), ""),
+		ConflictsWith: []string{fields.Username.String(), fields.AccessToken.String()},
+	},
 	fields.CaCert.String(): {
 		Type:        schema.TypeString,
 		Required:    true,
 		DefaultFunc: schema.EnvDefaultFunc("UAA_CA_CERT", ""),
 	},
+	fields.PageSize.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		DefaultFunc:  schema.EnvDefaultFunc(envvars.UaaPageSize.String(), api.DefaultPageSize),
+		ValidateFunc: validation.IntBetween(1, api.MaxPageSize),
+	},
+	fields.ForceOverwrite.String(): {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc(envvars.UaaForceOverwrite.String(), false),
+	},
+	fields.RetryMaxAttempts.String(): {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		DefaultFunc:  schema.EnvDefaultFunc(envvars.UaaRetryMaxAttempts.String(), api.DefaultRetryMaxAttempts),
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	fields.RetryBaseBackoff.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		DefaultFunc:  schema.EnvDefaultFunc(envvars.UaaRetryBaseBackoff.String(), api.DefaultRetryBaseBackoff.String()),
+		ValidateFunc: validateDuration,
+	},
+	fields.RetryMaxBackoff.String(): {
+		Type:         schema.TypeString,
+		Optional:     true,
+		DefaultFunc:  schema.EnvDefaultFunc(envvars.UaaRetryMaxBackoff.String(), api.DefaultRetryMaxBackoff.String()),
+		ValidateFunc: validateDuration,
+	},
+	fields.RetryStatusCodes.String(): {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntBetween(400, 599),
+		},
+	},
+	fields.ZoneSubdomain.String(): {
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc(envvars.UaaZoneSubdomain.String(), ""),
+	},
 	fields.SkipSslValidation.String(): {
 		Type:        schema.TypeBool,
 		Required:    true,
 		DefaultFunc: schema.EnvDefaultFunc("UAA_SKIP_SSL_VALIDATION", "true"),
 	},
 }
+
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if d, err := time.ParseDuration(v); err != nil || d < 0 {
+		return nil, []error{fmt.Errorf("expected %s to be a positive duration such as \"500ms\" or \"1m\", got: %s", k, v)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,36 @@
+package fields
+
+type SamlServiceProviderField int64
+
+const (
+	AttributeMappings SamlServiceProviderField = iota
+	EnableIdpInitiatedSso
+	EntityId
+	IsActive
+	Metadata
+	Name
+	NameId
+	ZoneId
+)
+
+func (s SamlServiceProviderField) String() string {
+	switch s {
+	case AttributeMappings:
+		return "attribute_mappings"
+	case EnableIdpInitiatedSso:
+		return "enable_idp_initiated_sso"
+	case EntityId:
+		return "entity_id"
+	case IsActive:
+		return "is_active"
+	case Metadata:
+		return "metadata"
+	case Name:
+		return "name"
+	case NameId:
+		return "name_id"
+	case ZoneId:
+		return "zone_id"
+	}
+	return "unknown"
+}
@@ -0,0 +1,59 @@
+package samlserviceprovider
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/samlserviceprovider/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Mapper methods for mapping API objects to TF resources
+
+func MapSamlServiceProviderToResource(serviceProvider *api.SamlServiceProvider, data *schema.ResourceData) {
+
+	data.SetId(serviceProvider.Id)
+	data.Set(fields.EntityId.String(), serviceProvider.EntityId)
+	data.Set(fields.IsActive.String(), serviceProvider.IsActive)
+	data.Set(fields.Name.String(), serviceProvider.Name)
+	data.Set(fields.ZoneId.String(), serviceProvider.IdentityZoneId)
+
+	config := serviceProvider.Config
+	if config == nil {
+		return
+	}
+
+	data.Set(fields.AttributeMappings.String(), mapAttributeMappingsToInterface(config.AttributeMappings))
+	data.Set(fields.EnableIdpInitiatedSso.String(), config.EnableIdpInitiatedSso)
+	data.Set(fields.Metadata.String(), config.MetadataLocation)
+	data.Set(fields.NameId.String(), config.NameId)
+}
+
+// Only string valued attribute mappings are supported, as they're held in a TF map of strings.
+func mapAttributeMappingsToInterface(data map[string]interface{}) map[string]interface{} {
+
+	mappings := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		if value, ok := v.(string); ok {
+			mappings[k] = value
+		}
+	}
+
+	return mappings
+}
+
+// Mapper methods for mapping TF resources to API objects
+
+func MapResourceToSamlServiceProvider(data *schema.ResourceData) *api.SamlServiceProvider {
+
+	return &api.SamlServiceProvider{
+		Id:       data.Id(),
+		IsActive: data.Get(fields.IsActive.String()).(bool),
+		Name:     data.Get(fields.Name.String()).(string),
+		EntityId: data.Get(fields.EntityId.String()).(string),
+		Config: &api.SamlServiceProviderConfig{
+			AttributeMappings:     data.Get(fields.AttributeMappings.String()).(map[string]interface{}),
+			EnableIdpInitiatedSso: data.Get(fields.EnableIdpInitiatedSso.String()).(bool),
+			MetadataLocation:      data.Get(fields.Metadata.String()).(string),
+			NameId:                data.Get(fields.NameId.String()).(string),
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package samlserviceprovider
+
+import (
+	"context"
+	"fmt"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/samlserviceprovider/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var Resource = &schema.Resource{
+	Schema:        samlServiceProviderSchema,
+	CreateContext: createResource,
+	ReadContext:   readResource,
+	UpdateContext: updateResource,
+	DeleteContext: deleteResource,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
+}
+
+func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	sspm := session.SamlServiceProviderManager()
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	response, err := sspm.Create(MapResourceToSamlServiceProvider(data), zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("New SAML service provider created: %# v", response)
+
+	MapSamlServiceProviderToResource(response, data)
+
+	return nil
+}
+
+func readResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	sspm := session.SamlServiceProviderManager()
+	id := data.Id()
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	response, err := sspm.FindById(id, zoneId)
+	if err != nil {
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("SAML service provider with GUID '%s' no longer exists; removing it from state", id)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("SAML service provider with GUID '%s' retrieved: %# v", id, response)
+
+	MapSamlServiceProviderToResource(response, data)
+
+	return nil
+}
+
+func updateResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	sspm := session.SamlServiceProviderManager()
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	response, err := sspm.Update(data.Id(), MapResourceToSamlServiceProvider(data), zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("SAML service provider updated: %# v", response)
+
+	MapSamlServiceProviderToResource(response, data)
+
+	return nil
+}
+
+// Service providers are imported by their entity ID, which is usually a URL, so the zone can't be left out of the ID.
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId, entityId, err := util.ParseImportId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	serviceProvider, err := session.SamlServiceProviderManager().FindByEntityId(entityId, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(serviceProvider.Id)
+	data.Set(fields.ZoneId.String(), serviceProvider.IdentityZoneId)
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	sspm := session.SamlServiceProviderManager()
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	if err := sspm.Delete(data.Id(), zoneId); err != nil && !api.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package samlserviceprovider
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/samlserviceprovider/fields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var samlServiceProviderSchema = map[string]*schema.Schema{
+	fields.AttributeMappings.String(): {
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	fields.EnableIdpInitiatedSso.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+	fields.EntityId.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	fields.IsActive.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	fields.Metadata.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	fields.Name.String(): {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	fields.NameId.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+	},
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		ForceNew: true,
+		Optional: true,
+		Computed: true,
+	},
+}
@@ -0,0 +1,64 @@
+package tokenkeys
+
+import (
+	"context"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/tokenkeys/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/tokenkeys/keyfields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var DataSource = &schema.Resource{
+	Schema:      tokenKeysSchema,
+	ReadContext: readDataSource,
+}
+
+func readDataSource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	tkm := session.TokenKeyManager()
+	zoneId := data.Get(fields.ZoneId.String()).(string)
+
+	tokenKeys, jwks, err := tkm.GetTokenKeys(zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	activeKey, err := tkm.GetActiveTokenKey(zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The keys aren't logged, as symmetric keys are secrets
+	session.Log.DebugMessage("%d token keys retrieved", len(tokenKeys.Keys))
+
+	var keys []map[string]interface{}
+	activeKeyFound := false
+	for _, key := range tokenKeys.Keys {
+		activeKeyFound = activeKeyFound || activeKey != nil && key.Kid == activeKey.Kid
+		keys = append(keys, map[string]interface{}{
+			keyfields.Alg.String():   key.Alg,
+			keyfields.E.String():     key.E,
+			keyfields.Kid.String():   key.Kid,
+			keyfields.Kty.String():   key.Kty,
+			keyfields.N.String():     key.N,
+			keyfields.Use.String():   key.Use,
+			keyfields.Value.String(): key.Value,
+		})
+	}
+
+	if !activeKeyFound {
+		return diag.Errorf("the active token key of identity zone '%s' isn't among its token keys", session.ResolveZoneId(zoneId))
+	}
+
+	data.SetId(session.ResolveZoneId(zoneId))
+	data.Set(fields.ActiveKeyId.String(), activeKey.Kid)
+	data.Set(fields.Jwks.String(), jwks)
+	data.Set(fields.Keys.String(), keys)
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package fields
+
+type TokenKeysField int64
+
+const (
+	ActiveKeyId TokenKeysField = iota
+	Jwks
+	Keys
+	ZoneId
+)
+
+func (s TokenKeysField) String() string {
+	switch s {
+	case ActiveKeyId:
+		return "active_key_id"
+	case Jwks:
+		return "jwks"
+	case Keys:
+		return "keys"
+	case ZoneId:
+		return "zone_id"
+	}
+	return "unknown"
+}
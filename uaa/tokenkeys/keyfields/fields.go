@@ -0,0 +1,33 @@
+package keyfields
+
+type KeyField int64
+
+const (
+	Alg KeyField = iota
+	E
+	Kid
+	Kty
+	N
+	Use
+	Value
+)
+
+func (s KeyField) String() string {
+	switch s {
+	case Alg:
+		return "alg"
+	case E:
+		return "e"
+	case Kid:
+		return "kid"
+	case Kty:
+		return "kty"
+	case N:
+		return "n"
+	case Use:
+		return "use"
+	case Value:
+		return "value"
+	}
+	return "unknown"
+}
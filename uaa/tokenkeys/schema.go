@@ -0,0 +1,62 @@
+package tokenkeys
+
+import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/tokenkeys/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/tokenkeys/keyfields"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var tokenKeysSchema = map[string]*schema.Schema{
+	fields.ActiveKeyId.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	fields.Jwks.String(): {
+		Type:      schema.TypeString,
+		Computed:  true,
+		Sensitive: true,
+	},
+	fields.Keys.String(): {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: keySchema,
+		},
+	},
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+}
+
+var keySchema = map[string]*schema.Schema{
+	keyfields.Alg.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	keyfields.E.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	keyfields.Kid.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	keyfields.Kty.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	keyfields.N.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	keyfields.Use.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	keyfields.Value.String(): {
+		Type:      schema.TypeString,
+		Computed:  true,
+		Sensitive: true,
+	},
+}
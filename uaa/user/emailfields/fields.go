@@ -0,0 +1,18 @@
+package emailfields
+
+type EmailField int64
+
+const (
+	Primary EmailField = iota
+	Value
+)
+
+func (s EmailField) String() string {
+	switch s {
+	case Primary:
+		return "primary"
+	case Value:
+		return "value"
+	}
+	return "unknown"
+}
@@ -3,32 +3,86 @@ package fields
 type UserField int64
 
 const (
-	Email UserField = iota
+	Active UserField = iota
+	Created
+	DisplayName
+	Email
+	Emails
+	ExternalId
 	FamilyName
 	GivenName
 	Groups
+	Id
+	LastLogonTime
+	LastModified
+	Locale
 	Name
 	Origin
 	Password
+	PasswordLastModified
+	PasswordVersion
+	PhoneNumbers
+	RequirePasswordChangeTrigger
+	Timezone
+	UnlockTrigger
+	Users
+	Verified
+	Version
 	ZoneId
 )
 
 func (s UserField) String() string {
 	switch s {
+	case Active:
+		return "active"
+	case Created:
+		return "created"
+	case DisplayName:
+		return "display_name"
 	case Email:
 		return "email"
+	case Emails:
+		return "emails"
+	case ExternalId:
+		return "external_id"
 	case FamilyName:
 		return "family_name"
 	case GivenName:
 		return "given_name"
 	case Groups:
 		return "groups"
+	case Id:
+		return "id"
+	case LastLogonTime:
+		return "last_logon_time"
+	case LastModified:
+		return "last_modified"
+	case Locale:
+		return "locale"
 	case Name:
 		return "name"
 	case Origin:
 		return "origin"
 	case Password:
 		return "password"
+	case PasswordLastModified:
+		return "password_last_modified"
+	case PasswordVersion:
+		return "password_version"
+	case PhoneNumbers:
+		return "phone_numbers"
+	case RequirePasswordChangeTrigger:
+		return "require_password_change_trigger"
+	case Timezone:
+		return "timezone"
+	case UnlockTrigger:
+		return "unlock_trigger"
+	case Users:
+		return "users"
+	case Verified:
+		return "verified"
+	case Version:
+		return "version"
 	case ZoneId:
 		return "zone_id"
 	}
@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/listoptions"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/user/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ListDataSource = &schema.Resource{
+	Schema:      listDataSourceSchema,
+	ReadContext: readListDataSource,
+}
+
+var listDataSourceSchema = listoptions.Schema(map[string]*schema.Schema{
+	fields.ZoneId.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	},
+	fields.Users.String(): {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Resource{Schema: listedUserSchema},
+	},
+})
+
+var listedUserSchema = map[string]*schema.Schema{
+	fields.Id.String():          {Type: schema.TypeString, Computed: true},
+	fields.Name.String():        {Type: schema.TypeString, Computed: true},
+	fields.Origin.String():      {Type: schema.TypeString, Computed: true},
+	fields.ExternalId.String():  {Type: schema.TypeString, Computed: true},
+	fields.Email.String():       {Type: schema.TypeString, Computed: true},
+	fields.GivenName.String():   {Type: schema.TypeString, Computed: true},
+	fields.FamilyName.String():  {Type: schema.TypeString, Computed: true},
+	fields.DisplayName.String(): {Type: schema.TypeString, Computed: true},
+	fields.Active.String():      {Type: schema.TypeBool, Computed: true},
+	fields.Verified.String():    {Type: schema.TypeBool, Computed: true},
+	fields.Groups.String(): {
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      util.ResourceStringHash,
+	},
+	fields.ZoneId.String(): {Type: schema.TypeString, Computed: true},
+}
+
+func readListDataSource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return diag.Errorf("client is nil")
+	}
+
+	options := listoptions.MapResourceToListOptions(data)
+	zoneId := session.ResolveZoneId(data.Get(fields.ZoneId.String()).(string))
+
+	users, err := session.UserManager().List(options, zoneId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	session.Log.DebugMessage("Listed %d users in zone '%s'", len(users), zoneId)
+
+	data.SetId(listoptions.Id(zoneId, options))
+	data.Set(fields.ZoneId.String(), zoneId)
+	data.Set(fields.Users.String(), MapUsersToList(users))
+
+	return nil
+}
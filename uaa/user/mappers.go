@@ -0,0 +1,170 @@
+package user
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/user/emailfields"
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/user/fields"
+	"github.com/foundcloudry/terraform-provider-uaa/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+// MapResourceToUser maps the user's SCIM attributes.  The groups are managed separately through group memberships.
+func MapResourceToUser(data *schema.ResourceData) api.UAAUser {
+
+	user := api.UAAUser{
+		ExternalId:  data.Get(fields.ExternalId.String()).(string),
+		Username:    data.Get(fields.Name.String()).(string),
+		Password:    configuredPassword(data),
+		Origin:      data.Get(fields.Origin.String()).(string),
+		DisplayName: data.Get(fields.DisplayName.String()).(string),
+		Active:      data.Get(fields.Active.String()).(bool),
+		Verified:    data.Get(fields.Verified.String()).(bool),
+		Locale:      data.Get(fields.Locale.String()).(string),
+		Timezone:    data.Get(fields.Timezone.String()).(string),
+		Name: api.UAAUserName{
+			GivenName:  data.Get(fields.GivenName.String()).(string),
+			FamilyName: data.Get(fields.FamilyName.String()).(string),
+		},
+	}
+
+	for _, v := range data.Get(fields.PhoneNumbers.String()).([]interface{}) {
+		user.PhoneNumbers = append(user.PhoneNumbers, api.UAAUserPhoneNumber{Value: v.(string)})
+	}
+
+	// A single email can be given instead of a list of emails, and if neither is the username is assumed to be the
+	// user's email address.  Both are computed from the other, so the configuration decides which one is used.
+	if hasConfiguredEmails(data) {
+		for _, v := range data.Get(fields.Emails.String()).(*schema.Set).List() {
+			email := v.(map[string]interface{})
+			user.Emails = append(user.Emails, api.UAAUserEmail{
+				Value:   email[emailfields.Value.String()].(string),
+				Primary: email[emailfields.Primary.String()].(bool),
+			})
+		}
+	} else if email, ok := data.GetOk(fields.Email.String()); ok {
+		user.Emails = []api.UAAUserEmail{{Value: email.(string), Primary: true}}
+	} else {
+		user.Emails = []api.UAAUserEmail{{Value: user.Username, Primary: true}}
+	}
+
+	return user
+}
+
+func MapUserToResource(user *api.UAAUser, data *schema.ResourceData) {
+
+	data.Set(fields.Name.String(), user.Username)
+	data.Set(fields.Origin.String(), user.Origin)
+	data.Set(fields.ExternalId.String(), user.ExternalId)
+	data.Set(fields.GivenName.String(), user.Name.GivenName)
+	data.Set(fields.FamilyName.String(), user.Name.FamilyName)
+	data.Set(fields.DisplayName.String(), user.DisplayName)
+	data.Set(fields.Active.String(), user.Active)
+	data.Set(fields.Verified.String(), user.Verified)
+	data.Set(fields.Locale.String(), user.Locale)
+	data.Set(fields.Timezone.String(), user.Timezone)
+	data.Set(fields.PasswordLastModified.String(), user.PasswordLastModified)
+	data.Set(fields.LastLogonTime.String(), formatEpochMillis(user.LastLogonTime))
+	data.Set(fields.ZoneId.String(), user.ZoneId)
+
+	var phoneNumbers []interface{}
+	for _, p := range user.PhoneNumbers {
+		phoneNumbers = append(phoneNumbers, p.Value)
+	}
+	data.Set(fields.PhoneNumbers.String(), phoneNumbers)
+
+	var emails []interface{}
+	for _, e := range user.Emails {
+		emails = append(emails, map[string]interface{}{
+			emailfields.Value.String():   e.Value,
+			emailfields.Primary.String(): e.Primary,
+		})
+	}
+	data.Set(fields.Emails.String(), emails)
+	data.Set(fields.Email.String(), primaryEmail(user.Emails))
+
+	if user.Meta != nil {
+		data.Set(fields.Created.String(), user.Meta.Created)
+		data.Set(fields.LastModified.String(), user.Meta.LastModified)
+		data.Set(fields.Version.String(), user.Meta.Version)
+	}
+}
+
+func hasConfiguredEmails(data *schema.ResourceData) bool {
+
+	config := data.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	emails := config.GetAttr(fields.Emails.String())
+	return !emails.IsNull() && emails.IsKnown() && emails.LengthInt() > 0
+}
+
+// configuredPassword returns the password from the configuration, as state only holds a hash of it.
+func configuredPassword(data *schema.ResourceData) string {
+
+	config := data.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return ""
+	}
+	password := config.GetAttr(fields.Password.String())
+	if password.IsNull() || !password.IsKnown() {
+		return ""
+	}
+	return password.AsString()
+}
+
+// hashPassword is stored in state in place of the password, which is enough to detect that it was changed.
+func hashPassword(password interface{}) string {
+	sum := sha256.Sum256([]byte(password.(string)))
+	return hex.EncodeToString(sum[:])
+}
+
+// formatEpochMillis formats the timestamps UAA returns as milliseconds since the epoch, which are 0 if not set.
+func formatEpochMillis(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
+
+func MapUsersToList(users []api.UAAUser) []map[string]interface{} {
+
+	list := []map[string]interface{}{}
+	for _, user := range users {
+		var groups []interface{}
+		for _, g := range user.Groups {
+			groups = append(groups, g.Display)
+		}
+
+		list = append(list, map[string]interface{}{
+			fields.Id.String():          user.Id,
+			fields.Name.String():        user.Username,
+			fields.Origin.String():      user.Origin,
+			fields.ExternalId.String():  user.ExternalId,
+			fields.Email.String():       primaryEmail(user.Emails),
+			fields.GivenName.String():   user.Name.GivenName,
+			fields.FamilyName.String():  user.Name.FamilyName,
+			fields.DisplayName.String(): user.DisplayName,
+			fields.Active.String():      user.Active,
+			fields.Verified.String():    user.Verified,
+			fields.Groups.String():      schema.NewSet(util.ResourceStringHash, groups),
+			fields.ZoneId.String():      user.ZoneId,
+		})
+	}
+	return list
+}
+
+// primaryEmail returns the user's primary email address, or the first one if none is marked as primary.
+func primaryEmail(emails []api.UAAUserEmail) string {
+
+	email := ""
+	for _, e := range emails {
+		if email == "" || e.Primary {
+			email = e.Value
+		}
+	}
+	return email
+}
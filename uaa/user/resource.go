@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"fmt"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/api"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/user/fields"
 	"github.com/foundcloudry/terraform-provider-uaa/util"
@@ -15,6 +16,17 @@ var Resource = &schema.Resource{
 	ReadContext:   readResource,
 	UpdateContext: updateResource,
 	DeleteContext: deleteResource,
+	Importer: &schema.ResourceImporter{
+		StateContext: importResource,
+	},
+	SchemaVersion: 1,
+	StateUpgraders: []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    (&schema.Resource{Schema: userSchema}).CoreConfigSchema().ImpliedType(),
+			Upgrade: upgradePasswordToHash,
+		},
+	},
 }
 
 func createResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -24,29 +36,23 @@ func createResource(ctx context.Context, data *schema.ResourceData, i interface{
 		return diag.Errorf("client is nil")
 	}
 
-	name := data.Get(fields.Name.String()).(string)
-	password := data.Get(fields.Password.String()).(string)
-	origin := data.Get(fields.Origin.String()).(string)
-	givenName := data.Get(fields.GivenName.String()).(string)
-	familyName := data.Get(fields.FamilyName.String()).(string)
 	zoneId := data.Get(fields.ZoneId.String()).(string)
 
-	email := name
-	if val, ok := data.GetOk(fields.Email.String()); ok {
-		email = val.(string)
-	} else {
-		data.Set(fields.Email.String(), email)
-	}
-
 	um := session.UserManager()
-	user, err := um.CreateUser(name, password, origin, givenName, familyName, email, zoneId)
+	user, err := um.CreateUser(MapResourceToUser(data), zoneId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	session.Log.DebugMessage("New user created: %# v", user)
 
 	data.SetId(user.Id)
-	data.Set(fields.ZoneId.String(), user.ZoneId)
+	MapUserToResource(user, data)
+
+	if data.Get(fields.RequirePasswordChangeTrigger.String()).(string) != "" {
+		if _, err := um.RequirePasswordChange(user.Id, zoneId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return updateClientRoles(um, data)
 }
@@ -64,17 +70,16 @@ func readResource(ctx context.Context, data *schema.ResourceData, i interface{})
 
 	user, err := um.GetUser(id, zoneId)
 	if err != nil {
-		data.SetId("")
+		if api.IsNotFound(err) {
+			session.Log.DebugMessage("User with GUID '%s' no longer exists; removing it from state", id)
+			data.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	session.Log.DebugMessage("User with GUID '%s' retrieved: %# v", id, user)
 
-	data.Set(fields.Name.String(), user.Username)
-	data.Set(fields.Origin.String(), user.Origin)
-	data.Set(fields.GivenName.String(), user.Name.GivenName)
-	data.Set(fields.FamilyName.String(), user.Name.FamilyName)
-	data.Set(fields.Email.String(), user.Emails[0].Value)
-	data.Set(fields.ZoneId.String(), user.ZoneId)
+	MapUserToResource(user, data)
 
 	var groups []interface{}
 	for _, g := range user.Groups {
@@ -102,33 +107,93 @@ func updateResource(ctx context.Context, data *schema.ResourceData, i interface{
 
 	um := session.UserManager()
 
-	isModified := false
-	name := util.GetChangedValueString(fields.Name.String(), &isModified, data)
-	givenName := util.GetChangedValueString(fields.GivenName.String(), &isModified, data)
-	familyName := util.GetChangedValueString(fields.FamilyName.String(), &isModified, data)
-	email := util.GetChangedValueString(fields.Email.String(), &isModified, data)
-	zoneId := util.GetChangedValueString(fields.ZoneId.String(), &isModified, data)
+	name := data.Get(fields.Name.String()).(string)
+	zoneId := data.Get(fields.ZoneId.String()).(string)
 
-	if isModified {
-		user, err := um.UpdateUser(id, *name, *givenName, *familyName, *email, *zoneId)
+	if data.HasChanges(
+		fields.Name.String(),
+		fields.ExternalId.String(),
+		fields.GivenName.String(),
+		fields.FamilyName.String(),
+		fields.DisplayName.String(),
+		fields.Email.String(),
+		fields.Emails.String(),
+		fields.PhoneNumbers.String(),
+		fields.Active.String(),
+		fields.Verified.String(),
+		fields.Locale.String(),
+		fields.Timezone.String(),
+	) {
+		// The password can only be changed through the password endpoint
+		userResource := MapResourceToUser(data)
+		userResource.Password = ""
+
+		version := data.Get(fields.Version.String()).(int)
+		user, err := um.UpdateUser(id, userResource, version, zoneId)
 		if err != nil {
+			if api.IsConflict(err) {
+				return util.ConflictDiagnostics("user", id, err)
+			}
 			return diag.FromErr(err)
 		}
 		session.Log.DebugMessage("User updated: %# v", user)
+
+		MapUserToResource(user, data)
 	}
 
-	updatePassword, oldPassword, newPassword := util.GetResourceChange(fields.Password.String(), data)
-	if updatePassword {
-		err := um.ChangePassword(id, oldPassword, newPassword, *zoneId)
+	// The password is reset rather than changed, so that the previous password doesn't need to be known.  That isn't
+	// the case after an import or when it was changed outside of terraform, which `password_version` accounts for.
+	password := configuredPassword(data)
+	if password != "" && data.HasChanges(fields.Password.String(), fields.PasswordVersion.String()) {
+		err := um.ChangePassword(id, password, zoneId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 		session.Log.DebugMessage("Password for user with id '%s' and name %s' updated.", id, name)
 	}
 
+	// UAA clears the requirement once the user has changed their password, so removing the trigger doesn't undo it
+	if data.HasChange(fields.RequirePasswordChangeTrigger.String()) && data.Get(fields.RequirePasswordChangeTrigger.String()).(string) != "" {
+		if _, err := um.RequirePasswordChange(id, zoneId); err != nil {
+			return diag.FromErr(err)
+		}
+		session.Log.DebugMessage("User with id '%s' must change their password on next login.", id)
+	}
+
+	if data.HasChange(fields.UnlockTrigger.String()) {
+		status, err := um.UnlockUser(id, zoneId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		session.Log.DebugMessage("User with id '%s' unlocked: %# v", id, status)
+	}
+
 	return updateClientRoles(um, data)
 }
 
+func importResource(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+
+	session := i.(*api.Session)
+	if session == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+
+	zoneId, username, err := util.ParseImportId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := session.UserManager().FindByUsername(username, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(user.Id)
+	data.Set(fields.ZoneId.String(), user.ZoneId)
+
+	return []*schema.ResourceData{data}, nil
+}
+
 func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 
 	session := i.(*api.Session)
@@ -140,7 +205,9 @@ func deleteResource(ctx context.Context, data *schema.ResourceData, i interface{
 	zoneId := data.Get(fields.ZoneId.String()).(string)
 	um := session.UserManager()
 
-	_ = um.DeleteUser(id, zoneId)
+	if err := um.DeleteUser(id, zoneId); err != nil && !api.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -158,3 +225,12 @@ func updateClientRoles(um *api.UserManager, data *schema.ResourceData) diag.Diag
 
 	return nil
 }
+
+// upgradePasswordToHash replaces the password that versions before 1 kept in state with its hash.
+func upgradePasswordToHash(ctx context.Context, rawState map[string]interface{}, i interface{}) (map[string]interface{}, error) {
+
+	if password, ok := rawState[fields.Password.String()].(string); ok && password != "" {
+		rawState[fields.Password.String()] = hashPassword(password)
+	}
+	return rawState, nil
+}
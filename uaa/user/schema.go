@@ -1,6 +1,7 @@
 package user
 
 import (
+	"github.com/foundcloudry/terraform-provider-uaa/uaa/user/emailfields"
 	"github.com/foundcloudry/terraform-provider-uaa/uaa/user/fields"
 	"github.com/foundcloudry/terraform-provider-uaa/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +16,11 @@ var userSchema = map[string]*schema.Schema{
 		Type:      schema.TypeString,
 		Optional:  true,
 		Sensitive: true,
+		StateFunc: hashPassword,
+	},
+	fields.PasswordVersion.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
 	},
 	fields.Origin.String(): {
 		Type:     schema.TypeString,
@@ -22,6 +28,10 @@ var userSchema = map[string]*schema.Schema{
 		Optional: true,
 		Default:  "uaa",
 	},
+	fields.ExternalId.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
 	fields.GivenName.String(): {
 		Type:     schema.TypeString,
 		Optional: true,
@@ -30,9 +40,66 @@ var userSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
 	},
+	fields.DisplayName.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
 	fields.Email.String(): {
+		Type:          schema.TypeString,
+		Computed:      true,
+		Optional:      true,
+		ConflictsWith: []string{fields.Emails.String()},
+	},
+	fields.Emails.String(): {
+		Type:          schema.TypeSet,
+		Computed:      true,
+		Optional:      true,
+		ConflictsWith: []string{fields.Email.String()},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				emailfields.Value.String(): {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				emailfields.Primary.String(): {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	},
+	fields.PhoneNumbers.String(): {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+	fields.Active.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	fields.Verified.String(): {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	fields.RequirePasswordChangeTrigger.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	fields.UnlockTrigger.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	fields.Locale.String(): {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	fields.Timezone.String(): {
 		Type:     schema.TypeString,
-		Computed: true,
 		Optional: true,
 	},
 	fields.Groups.String(): {
@@ -43,6 +110,26 @@ var userSchema = map[string]*schema.Schema{
 		},
 		Set: util.ResourceStringHash,
 	},
+	fields.PasswordLastModified.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	fields.LastLogonTime.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	fields.Created.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	fields.LastModified.String(): {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	fields.Version.String(): {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
 	fields.ZoneId.String(): {
 		Type:     schema.TypeString,
 		ForceNew: true,
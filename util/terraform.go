@@ -1,7 +1,12 @@
 package util
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
 )
 
 func GetChangedValueString(key string, updated *bool, d *schema.ResourceData) *string {
@@ -90,3 +95,51 @@ func GetListChanges(old interface{}, new interface{}) (remove []string, add []st
 	}
 	return
 }
+
+// ParseImportId splits an import ID of the form `<zone_id>/<name>` into its parts.  The zone is required, as zone IDs
+// can't contain a `/` while names such as SAML entity IDs or LDAP DNs often do.
+func ParseImportId(id string) (zoneId, name string, err error) {
+
+	zoneId, name, found := strings.Cut(id, "/")
+	if !found || zoneId == "" || name == "" {
+		return "", "", fmt.Errorf("invalid import ID '%s', expected '<zone_id>/<name>'", id)
+	}
+	return
+}
+
+// ConflictDiagnostics explains that an update was rejected because the resource was changed outside of terraform since
+// it was last read.
+func ConflictDiagnostics(resourceType, id string, err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("The %s with ID '%s' was modified outside of terraform", resourceType, id),
+			Detail: fmt.Sprintf("UAA rejected the update because the %s has changed since it was last read: %s\n\n"+
+				"Run `terraform apply` again to review the changes against the current state of the %s, or set the "+
+				"provider's `force_overwrite` option to overwrite changes made by others.", resourceType, err.Error(), resourceType),
+		},
+	}
+}
+
+// SuppressEquivalentJson ignores differences in the formatting of JSON documents, such as whitespace and key order.
+func SuppressEquivalentJson(k, old, new string, d *schema.ResourceData) bool {
+	return NormalizeJson(old) == NormalizeJson(new)
+}
+
+// NormalizeJson returns the JSON document in compact form with its keys sorted, or the document itself if it isn't
+// valid JSON.
+func NormalizeJson(document string) string {
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return document
+	}
+
+	normalized := bytes.Buffer{}
+	encoder := json.NewEncoder(&normalized)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return document
+	}
+	return strings.TrimSpace(normalized.String())
+}